@@ -0,0 +1,34 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+// AccountResource is the resource type reported by account errors
+const AccountResource = "account"
+
+// Account is a user of the identity service, its email is unique per tenant
+type Account struct {
+	ID           uint     `json:"id"`
+	Email        string   `json:"email"`
+	PasswordHash string   `json:"-"`
+	Roles        []string `json:"roles"`
+	// TokenVersion is incremented on every password change, revoking the refresh tokens issued before it
+	TokenVersion uint      `json:"token_version"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	// TenantID is set by the service, from the caller's context (see WithTenant)
+	TenantID string `json:"tenant_id"`
+}
+
+// AccountService stores accounts, passwords are hashed and validated by the IdentityService
+type AccountService interface {
+	Get(c context.Context, id uint) (*Account, error)
+	// GetByEmail looks up an account by its email, the IdentityService normalizes emails before storing them
+	GetByEmail(c context.Context, email string) (*Account, error)
+	// Create fails with a conflict if the email is already taken
+	Create(c context.Context, a *Account) (*Account, error)
+	// SetPasswordHash replaces the password hash and increments the token version
+	SetPasswordHash(c context.Context, id uint, passwordHash string) (*Account, error)
+}
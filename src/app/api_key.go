@@ -0,0 +1,40 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+// APIKeyResource is the resource type reported by API key errors
+const APIKeyResource = "api_key"
+
+// APIKey authenticates service-to-service calls on behalf of a tenant. Only a hash of the key is stored,
+// its Prefix is a public identifier to look it up by
+type APIKey struct {
+	ID         uint       `json:"id"`
+	Name       string     `json:"name" validate:"required,lte=255"`
+	Prefix     string     `json:"prefix"`
+	KeyHash    string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	Roles      []string   `json:"roles"`
+	ExpiresAt  *time.Time `json:"expires_at"` // Never expires if nil
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	// TenantID is set by the service, from the caller's context (see WithTenant)
+	TenantID string `json:"tenant_id"`
+}
+
+// APIKeyService stores API keys, keys are generated and hashed by the identity package
+type APIKeyService interface {
+	Get(c context.Context, id uint) (*APIKey, error)
+	// GetByPrefix looks up a key of any tenant, to authenticate it. Revoked keys aren't found
+	GetByPrefix(c context.Context, prefix string) (*APIKey, error)
+	Create(c context.Context, k *APIKey) (*APIKey, error)
+	// SetSecret replaces the prefix and the hash of a key, e.g to rotate it
+	SetSecret(c context.Context, id uint, prefix string, keyHash string) (*APIKey, error)
+	// Revoke soft deletes a key, it isn't found from now on
+	Revoke(c context.Context, id uint) error
+	// TouchLastUsed sets the last used time of keys of any tenant, by key ID
+	TouchLastUsed(c context.Context, lastUsed map[uint]time.Time) error
+}
@@ -0,0 +1,276 @@
+// Package apptest holds the tests every app.VisitService implementation must pass, they're run by the tests of each
+package apptest
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+)
+
+// NewVisitService creates an empty VisitService to test. setCreatedAt overrides the creation time of one of its visits,
+// e.g to list visits created at the same time
+type NewVisitService func(t *testing.T) (vs app.VisitService, setCreatedAt func(t *testing.T, id uint, createdAt time.Time))
+
+func tenant(id string) context.Context {
+	return app.WithTenant(context.Background(), id)
+}
+
+// newListedVisits returns a VisitService holding visits with tied names and creation times
+func newListedVisits(t *testing.T, newVisitService NewVisitService) app.VisitService {
+	t.Helper()
+
+	vs, setCreatedAt := newVisitService(t)
+
+	created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, v := range []struct {
+		firstName, lastName string
+		createdAt           time.Time
+	}{
+		{"John", "Doe", created.Add(2 * time.Second)},
+		{"Jane", "Doe", created},
+		{"Adam", "Smith", created.Add(time.Second)},
+		{"John", "Adams", created},
+		{"Bob", "Doe", created.Add(2 * time.Second)},
+	} {
+		created, err := vs.Create(tenant("acme"), &app.Visit{FirstName: v.firstName, LastName: v.lastName})
+		if err != nil {
+			t.Fatal(err)
+		}
+		setCreatedAt(t, created.ID, v.createdAt)
+	}
+
+	// Another tenant's visit is never listed
+	if _, err := vs.Create(tenant("globex"), &app.Visit{FirstName: "Jane", LastName: "Doe"}); err != nil {
+		t.Fatal(err)
+	}
+
+	return vs
+}
+
+// VisitServiceList walks through all the pages of every order
+func VisitServiceList(t *testing.T, newVisitService NewVisitService) {
+	tests := []struct {
+		name    string
+		filter  app.VisitFilter
+		wantIDs []uint
+	}{
+		{name: "by id", wantIDs: []uint{1, 2, 3, 4, 5}},
+		{name: "by id, descending", filter: app.VisitFilter{Descending: true}, wantIDs: []uint{5, 4, 3, 2, 1}},
+		{name: "by first name", filter: app.VisitFilter{OrderBy: app.VisitOrderByFirstName}, wantIDs: []uint{3, 5, 2, 1, 4}},
+		{name: "by first name, descending", filter: app.VisitFilter{OrderBy: app.VisitOrderByFirstName, Descending: true}, wantIDs: []uint{4, 1, 2, 5, 3}},
+		{name: "by last name", filter: app.VisitFilter{OrderBy: app.VisitOrderByLastName}, wantIDs: []uint{4, 1, 2, 5, 3}},
+		{name: "by created at", filter: app.VisitFilter{OrderBy: app.VisitOrderByCreatedAt}, wantIDs: []uint{2, 4, 3, 1, 5}},
+		{name: "by created at, descending", filter: app.VisitFilter{OrderBy: app.VisitOrderByCreatedAt, Descending: true}, wantIDs: []uint{5, 1, 3, 4, 2}},
+		{name: "last name prefix", filter: app.VisitFilter{OrderBy: app.VisitOrderByFirstName, LastNamePrefix: "DO"}, wantIDs: []uint{5, 2, 1}},
+		{name: "no match", filter: app.VisitFilter{FirstNamePrefix: "Zoe"}, wantIDs: []uint{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs := newListedVisits(t, newVisitService)
+
+			// Walk through all the pages, two visits at a time
+			f := tt.filter
+			f.PageSize = 2
+			ids := []uint{}
+			for pages := 0; ; pages++ {
+				if pages > len(tt.wantIDs) {
+					t.Fatal("expected the pages to end")
+				}
+
+				page, err := vs.List(tenant("acme"), &f)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, v := range page.Visits {
+					ids = append(ids, v.ID)
+				}
+
+				if page.NextPageToken == "" {
+					break
+				}
+				f.PageToken = page.NextPageToken
+			}
+
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("expected %v, got %v", tt.wantIDs, ids)
+			}
+		})
+	}
+}
+
+// VisitServiceListInvalidPageToken lists using tokens that don't continue the requested order
+func VisitServiceListInvalidPageToken(t *testing.T, newVisitService NewVisitService) {
+	v := &app.Visit{ID: 1, FirstName: "John", CreatedAt: time.Now()}
+
+	tests := []struct {
+		name   string
+		filter app.VisitFilter
+		token  string
+	}{
+		{name: "not base64", token: "!!"},
+		{name: "not json", token: base64.RawURLEncoding.EncodeToString([]byte("visit"))},
+		{name: "other order", filter: app.VisitFilter{OrderBy: app.VisitOrderByLastName}, token: app.EncodeVisitPageToken(app.VisitOrderByFirstName, false, v)},
+		{name: "other direction", filter: app.VisitFilter{OrderBy: app.VisitOrderByFirstName}, token: app.EncodeVisitPageToken(app.VisitOrderByFirstName, true, v)},
+		{name: "bad created at", filter: app.VisitFilter{OrderBy: app.VisitOrderByCreatedAt},
+			token: base64.RawURLEncoding.EncodeToString([]byte(`{"o":"created_at","v":"yesterday","i":1}`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs := newListedVisits(t, newVisitService)
+
+			f := tt.filter
+			f.PageToken = tt.token
+			if _, err := vs.List(tenant("acme"), &f); err != app.ErrInvalidPageToken {
+				t.Errorf("expected %v, got %v", app.ErrInvalidPageToken, err)
+			}
+		})
+	}
+}
+
+// VisitServiceUpdateFields updates the fields of a field mask only
+func VisitServiceUpdateFields(t *testing.T, newVisitService NewVisitService) {
+	tests := []struct {
+		name          string
+		fields        []string
+		update        app.Visit
+		wantErr       bool
+		wantFirstName string
+		wantLastName  string
+	}{
+		{name: "all fields", update: app.Visit{FirstName: "Jane", LastName: "Roe"}, wantFirstName: "Jane", wantLastName: "Roe"},
+		{name: "first name", fields: []string{app.VisitFieldFirstName}, update: app.Visit{FirstName: "Jane"}, wantFirstName: "Jane", wantLastName: "Doe"},
+		{name: "last name", fields: []string{app.VisitFieldLastName}, update: app.Visit{FirstName: "Jane", LastName: "Roe"}, wantFirstName: "John", wantLastName: "Roe"},
+		{name: "not updatable", fields: []string{"TenantID"}, update: app.Visit{TenantID: "globex"}, wantErr: true},
+		{name: "invalid masked field", fields: []string{app.VisitFieldFirstName}, update: app.Visit{FirstName: ""}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs, _ := newVisitService(t)
+			c := tenant("acme")
+			v, err := vs.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			update := tt.update
+			update.ID = v.ID
+			_, err = vs.Update(c, &update, tt.fields)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			got, err := vs.Get(c, &v.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantErr {
+				if got.FirstName != v.FirstName || got.LastName != v.LastName || got.Version != v.Version {
+					t.Errorf("expected the visit to be left as is, got %+v", got)
+				}
+				return
+			}
+			if got.FirstName != tt.wantFirstName || got.LastName != tt.wantLastName || got.TenantID != "acme" {
+				t.Errorf("expected %s %s, got %+v", tt.wantFirstName, tt.wantLastName, got)
+			}
+		})
+	}
+}
+
+// VisitServiceUpdateVersion updates a visit of version 2, expecting the given version
+func VisitServiceUpdateVersion(t *testing.T, newVisitService NewVisitService) {
+	tests := []struct {
+		name        string
+		version     uint
+		wantErrCode string
+		wantVersion uint
+	}{
+		{name: "no version", version: 0, wantVersion: 3},
+		{name: "current version", version: 2, wantVersion: 3},
+		{name: "stale version", version: 1, wantErrCode: app.ErrCodeFailedPrecondition, wantVersion: 2},
+		{name: "future version", version: 3, wantErrCode: app.ErrCodeFailedPrecondition, wantVersion: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs, _ := newVisitService(t)
+			c := tenant("acme")
+			v, err := vs.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Version 2
+			if _, err := vs.Update(c, &app.Visit{ID: v.ID, FirstName: "Jane"}, []string{app.VisitFieldFirstName}); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = vs.Update(c, &app.Visit{ID: v.ID, FirstName: "Joe", Version: tt.version}, []string{app.VisitFieldFirstName})
+			if code := app.ErrorCode(err); code != tt.wantErrCode {
+				t.Fatalf("expected error code %q, got %v", tt.wantErrCode, err)
+			}
+
+			got, err := vs.Get(c, &v.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Version != tt.wantVersion {
+				t.Errorf("expected version %d, got %d", tt.wantVersion, got.Version)
+			}
+		})
+	}
+}
+
+// VisitServiceBatchSetSameVisit writes a visit twice in a batch, the second write applies on top of the first
+func VisitServiceBatchSetSameVisit(t *testing.T, newVisitService NewVisitService) {
+	tests := []struct {
+		name         string
+		version      uint
+		wantErrCode  string
+		wantLastName string
+		wantVersion  uint
+	}{
+		{name: "no version", wantLastName: "Roe", wantVersion: 3},
+		{name: "version of the previous write", version: 2, wantLastName: "Roe", wantVersion: 3},
+		{name: "version read before the batch", version: 1, wantErrCode: app.ErrCodeFailedPrecondition, wantLastName: "Doe", wantVersion: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs, _ := newVisitService(t)
+			c := tenant("acme")
+			v, err := vs.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Each write of the visit applies on top of the previous one
+			results, err := vs.BatchSet(c, []*app.Visit{
+				{ID: v.ID, FirstName: "Jane", LastName: "Doe", Version: 1},
+				{ID: v.ID, FirstName: "Jane", LastName: "Roe", Version: tt.version},
+			}, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if results[0].Err != nil {
+				t.Fatalf("unexpected error %v", results[0].Err)
+			}
+			if code := app.ErrorCode(results[1].Err); code != tt.wantErrCode {
+				t.Fatalf("expected error code %q, got %v", tt.wantErrCode, results[1].Err)
+			}
+
+			got, err := vs.Get(c, &v.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.FirstName != "Jane" || got.LastName != tt.wantLastName || got.Version != tt.wantVersion {
+				t.Errorf("expected Jane %s version %d, got %+v", tt.wantLastName, tt.wantVersion, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/pkg/replica"
+	"github.com/gomodule/redigo/redis"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
+)
+
+// Cache lookup results, used as the metric's label
+const (
+	resultHit   = "hit"
+	resultMiss  = "miss"
+	resultError = "error"
+)
+
+// NewVisitService decorates a VisitService with a read-through Redis cache of Get, visits are cached for ttl.
+// A miss reads the visit from the primary within loadTimeout, whether or not its caller is still waiting.
+// Writes invalidate the written visits. Redis errors are counted but never fail a call, next is used instead.
+// Within a unit of work (see app.Transactor) the cache isn't used, reads go through its transaction.
+func NewVisitService(next app.VisitService, pool *redis.Pool, ttl time.Duration, loadTimeout time.Duration, reg prometheus.Registerer) (*visitService, error) {
+	if ttl <= 0 {
+		return nil, errors.New("visit cache ttl must be positive")
+	}
+	if loadTimeout <= 0 {
+		return nil, errors.New("visit cache load timeout must be positive")
+	}
+
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "visit_cache_requests_total",
+		Help: "Total number of visit cache requests, by result. Failed writes and invalidations count as errors.",
+	}, []string{"result"})
+	for _, result := range []string{resultHit, resultMiss, resultError} {
+		requests.WithLabelValues(result)
+	}
+
+	if err := reg.Register(requests); err != nil {
+		return nil, err
+	}
+
+	return &visitService{VisitService: next, pool: pool, ttl: ttl, loadTimeout: loadTimeout, requests: requests}, nil
+}
+
+type visitService struct {
+	app.VisitService
+	pool        *redis.Pool
+	ttl         time.Duration
+	loadTimeout time.Duration
+	requests    *prometheus.CounterVec
+	group       singleflight.Group
+}
+
+func (vs *visitService) Get(c context.Context, id *uint) (*app.Visit, error) {
+	// The transaction may see its own uncommitted writes, which must neither be cached nor shared with other callers
+	if _, ok := app.TxFromContext(c); ok {
+		return vs.VisitService.Get(c, id)
+	}
+
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	key := visitKey(tenantID, *id)
+
+	if v, err := vs.get(c, key); err != nil {
+		vs.requests.WithLabelValues(resultError).Inc()
+	} else if v != nil {
+		vs.requests.WithLabelValues(resultHit).Inc()
+		return v, nil
+	} else {
+		vs.requests.WithLabelValues(resultMiss).Inc()
+	}
+
+	// Concurrent misses of the same visit share a single read. It isn't bound to the caller that started it,
+	// the others would fail along with it once it's canceled
+	res := vs.group.DoChan(key, func() (interface{}, error) {
+		lc, cancel := context.WithTimeout(detach(c), vs.loadTimeout)
+		defer cancel()
+
+		// A replica may lag behind a write that was just invalidated, the visit would be cached as it was before it
+		v, err := vs.VisitService.Get(replica.WithPrimary(lc), id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := vs.set(lc, key, v); err != nil {
+			vs.requests.WithLabelValues(resultError).Inc()
+		}
+		return v, nil
+	})
+
+	select {
+	case <-c.Done():
+		return nil, c.Err()
+	case r := <-res:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+
+		// Callers may modify the visit, don't share it
+		visit := *r.Val.(*app.Visit)
+		return &visit, nil
+	}
+}
+
+func (vs *visitService) Update(c context.Context, v *app.Visit, fields []string) (*app.Visit, error) {
+	defer vs.invalidate(c, v.ID)
+	return vs.VisitService.Update(c, v, fields)
+}
+
+func (vs *visitService) BatchSet(c context.Context, visits []*app.Visit, atomic bool) ([]*app.VisitBatchResult, error) {
+	var ids []uint
+	for _, v := range visits {
+		if v.ID != 0 {
+			ids = append(ids, v.ID)
+		}
+	}
+
+	defer vs.invalidate(c, ids...)
+	return vs.VisitService.BatchSet(c, visits, atomic)
+}
+
+func (vs *visitService) Delete(c context.Context, id *uint) error {
+	defer vs.invalidate(c, *id)
+	return vs.VisitService.Delete(c, id)
+}
+
+func (vs *visitService) Purge(c context.Context, id *uint) error {
+	defer vs.invalidate(c, *id)
+	return vs.VisitService.Purge(c, id)
+}
+
+// get returns the cached visit, nil if it isn't cached
+func (vs *visitService) get(c context.Context, key string) (*app.Visit, error) {
+	conn, err := vs.pool.GetContext(c)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	b, err := redis.Bytes(redis.DoContext(conn, c, "GET", key))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	v := &app.Visit{}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func (vs *visitService) set(c context.Context, key string, v *app.Visit) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	conn, err := vs.pool.GetContext(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = redis.DoContext(conn, c, "SET", key, b, "PX", vs.ttl.Milliseconds())
+	return err
+}
+
+// invalidate removes the given visits of the tenant of c from the cache. It runs after the write, whether it succeeded or not;
+// a write that timed out may still have been applied. Within a unit of work (see app.Transactor), it runs once the
+// unit of work is committed, until then other readers may still cache the visits as they were
+func (vs *visitService) invalidate(c context.Context, ids ...uint) {
+	// Without a tenant the write failed, nothing to invalidate
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil || len(ids) == 0 {
+		return
+	}
+
+	keys := make([]interface{}, len(ids))
+	for i, id := range ids {
+		keys[i] = visitKey(tenantID, id)
+	}
+
+	app.AfterCommit(c, func() {
+		// The write is done, its caller may be gone but the stale visits must still be removed
+		ic, cancel := context.WithTimeout(detach(c), vs.loadTimeout)
+		defer cancel()
+
+		if err := vs.del(ic, keys...); err != nil {
+			vs.requests.WithLabelValues(resultError).Inc()
+		}
+	})
+}
+
+func (vs *visitService) del(c context.Context, keys ...interface{}) error {
+	conn, err := vs.pool.GetContext(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = redis.DoContext(conn, c, "DEL", keys...)
+	return err
+}
+
+// visitKey is namespaced by tenant, a visit is never served to another tenant even though IDs are global
+func visitKey(tenantID string, id uint) string {
+	return "visit:" + strconv.Quote(tenantID) + ":" + strconv.FormatUint(uint64(id), 10)
+}
+
+// detachedContext carries the values of its parent, e.g its tenant and trace, but not its deadline and cancellation
+type detachedContext struct {
+	parent context.Context
+}
+
+func detach(c context.Context) context.Context {
+	return detachedContext{c}
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+func (d detachedContext) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
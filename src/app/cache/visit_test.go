@@ -0,0 +1,281 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/app/memory"
+	v10validator "github.com/go-playground/validator/v10"
+	"github.com/gomodule/redigo/redis"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const testTenant = "acme"
+
+func newRedis(t *testing.T) (*miniredis.Miniredis, *redis.Pool) {
+	t.Helper()
+
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(mr.Close)
+
+	pool := &redis.Pool{Dial: func() (redis.Conn, error) { return redis.Dial("tcp", mr.Addr()) }}
+	t.Cleanup(func() { pool.Close() })
+
+	return mr, pool
+}
+
+// newCachedVisits returns a cached memory VisitService, along with a visit it cached
+func newCachedVisits(t *testing.T) (*visitService, *miniredis.Miniredis, *app.Visit) {
+	t.Helper()
+
+	mr, pool := newRedis(t)
+	next := memory.NewVisitService(v10validator.New(), app.NewVisitEvents(10, 10))
+	vs, err := NewVisitService(next, pool, time.Minute, time.Second, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := app.WithTenant(context.Background(), testTenant)
+	v, err := next.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vs.Get(c, &v.ID); err != nil {
+		t.Fatal(err)
+	}
+	if !mr.Exists(visitKey(testTenant, v.ID)) {
+		t.Fatal("expected the visit to be cached")
+	}
+
+	return vs, mr, v
+}
+
+func TestNewVisitService_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		ttl         time.Duration
+		loadTimeout time.Duration
+	}{
+		{name: "no ttl", ttl: 0, loadTimeout: time.Second},
+		{name: "negative ttl", ttl: -time.Second, loadTimeout: time.Second},
+		{name: "no load timeout", ttl: time.Minute, loadTimeout: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, pool := newRedis(t)
+			next := memory.NewVisitService(v10validator.New(), app.NewVisitEvents(10, 10))
+
+			if _, err := NewVisitService(next, pool, tt.ttl, tt.loadTimeout, prometheus.NewRegistry()); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestVisitService_Invalidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(c context.Context, vs app.VisitService, v *app.Visit) error
+		// First name read after the write, empty if the visit is gone
+		wantFirstName string
+	}{
+		{name: "update", wantFirstName: "Jane", write: func(c context.Context, vs app.VisitService, v *app.Visit) error {
+			_, err := vs.Update(c, &app.Visit{ID: v.ID, FirstName: "Jane"}, []string{app.VisitFieldFirstName})
+			return err
+		}},
+		{name: "failed update", wantFirstName: "John", write: func(c context.Context, vs app.VisitService, v *app.Visit) error {
+			_, err := vs.Update(c, &app.Visit{ID: v.ID, FirstName: "Jane", Version: 7}, []string{app.VisitFieldFirstName})
+			if err == nil {
+				return errors.New("expected a stale version to fail")
+			}
+			return nil
+		}},
+		{name: "batch set", wantFirstName: "Jane", write: func(c context.Context, vs app.VisitService, v *app.Visit) error {
+			_, err := vs.BatchSet(c, []*app.Visit{{ID: v.ID, FirstName: "Jane", LastName: "Doe"}}, true)
+			return err
+		}},
+		{name: "delete", write: func(c context.Context, vs app.VisitService, v *app.Visit) error {
+			return vs.Delete(c, &v.ID)
+		}},
+		{name: "purge", write: func(c context.Context, vs app.VisitService, v *app.Visit) error {
+			return vs.Purge(c, &v.ID)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs, mr, v := newCachedVisits(t)
+
+			c := app.WithTenant(context.Background(), testTenant)
+			if err := tt.write(c, vs, v); err != nil {
+				t.Fatal(err)
+			}
+
+			if mr.Exists(visitKey(testTenant, v.ID)) {
+				t.Fatal("expected the visit to be invalidated")
+			}
+
+			got, err := vs.Get(c, &v.ID)
+			if tt.wantFirstName == "" {
+				if !app.IsNotFound(err) {
+					t.Fatalf("expected the visit to be gone, got %v, %v", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.FirstName != tt.wantFirstName {
+				t.Errorf("expected first name %s, got %s", tt.wantFirstName, got.FirstName)
+			}
+		})
+	}
+}
+
+func TestVisitService_InvalidateAfterCommit(t *testing.T) {
+	errRollback := errors.New("rollback")
+
+	tests := []struct {
+		name            string
+		err             error
+		wantInvalidated bool
+	}{
+		{name: "commit", wantInvalidated: true},
+		{name: "rollback", err: errRollback},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs, mr, v := newCachedVisits(t)
+
+			db, err := sql.Open("sqlite3", ":memory:")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			uow := app.NewTransactor(db, func(err error) bool { return false }, 1)
+
+			c := app.WithTenant(context.Background(), testTenant)
+			err = uow.WithinTx(c, func(c context.Context) error {
+				if _, err := vs.Update(c, &app.Visit{ID: v.ID, FirstName: "Jane"}, []string{app.VisitFieldFirstName}); err != nil {
+					return err
+				}
+
+				// Until committed, other readers still see the visit as it was, it stays cached
+				if !mr.Exists(visitKey(testTenant, v.ID)) {
+					t.Error("expected the visit to be invalidated only once committed")
+				}
+				return tt.err
+			})
+			if err != tt.err {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+
+			if invalidated := !mr.Exists(visitKey(testTenant, v.ID)); invalidated != tt.wantInvalidated {
+				t.Errorf("expected invalidated %v, got %v", tt.wantInvalidated, invalidated)
+			}
+		})
+	}
+}
+
+// blockingVisitService blocks Get until released
+type blockingVisitService struct {
+	app.VisitService
+	release chan struct{}
+}
+
+func (bs *blockingVisitService) Get(c context.Context, id *uint) (*app.Visit, error) {
+	<-bs.release
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+	return bs.VisitService.Get(c, id)
+}
+
+func TestVisitService_Get_CallerGone(t *testing.T) {
+	mr, pool := newRedis(t)
+	next := &blockingVisitService{
+		VisitService: memory.NewVisitService(v10validator.New(), app.NewVisitEvents(10, 10)),
+		release:      make(chan struct{}),
+	}
+	vs, err := NewVisitService(next, pool, time.Minute, time.Second, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := app.WithTenant(context.Background(), testTenant)
+	v, err := next.VisitService.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first caller gives up while the visit is read
+	gone, cancel := context.WithCancel(c)
+	errs := make(chan error)
+	go func() {
+		_, err := vs.Get(gone, &v.ID)
+		errs <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-errs; err != context.Canceled {
+		t.Fatalf("expected the caller to be canceled, got %v", err)
+	}
+
+	// A concurrent caller shares the read, which isn't canceled along with the first caller
+	res := make(chan error)
+	go func() {
+		got, err := vs.Get(c, &v.ID)
+		if err == nil && got.FirstName != "John" {
+			err = errors.New("unexpected visit " + got.FirstName)
+		}
+		res <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(next.release)
+
+	if err := <-res; err != nil {
+		t.Fatal(err)
+	}
+	if !mr.Exists(visitKey(testTenant, v.ID)) {
+		t.Fatal("expected the visit to be cached")
+	}
+}
+
+func TestVisitService_Get_WithinTx(t *testing.T) {
+	vs, mr, v := newCachedVisits(t)
+	mr.FlushAll()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	uow := app.NewTransactor(db, func(err error) bool { return false }, 1)
+
+	c := app.WithTenant(context.Background(), testTenant)
+	err = uow.WithinTx(c, func(c context.Context) error {
+		if _, err := vs.Get(c, &v.ID); err != nil {
+			return err
+		}
+
+		// The unit of work may read its own uncommitted writes, they're never cached
+		if mr.Exists(visitKey(testTenant, v.ID)) {
+			t.Error("expected a read within a unit of work not to be cached")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error codes, transport agnostic. Each transport maps them to its own status codes
+const (
+	ErrCodeNotFound           = "not_found"
+	ErrCodeConflict           = "conflict"
+	ErrCodeInvalid            = "invalid"
+	ErrCodePermissionDenied   = "permission_denied"
+	ErrCodeAborted            = "aborted"             // Concurrent modification, re-read and retry
+	ErrCodeFailedPrecondition = "failed_precondition" // The state doesn't allow it, e.g the expected version doesn't match (see ReasonVersionMismatch)
+	ErrCodeUnauthenticated    = "unauthenticated"
+	ErrCodeRateLimited        = "rate_limited"
+)
+
+// ReasonVersionMismatch is the Reason of a FailedPrecondition error whose expected version doesn't match, re-read before retrying
+const ReasonVersionMismatch = "VERSION_MISMATCH"
+
+// Error is a domain error returned by our services
+type Error struct {
+	Code         string
+	ResourceType string // e.g "visit"
+	ResourceName string // e.g the record's ID
+	Field        string // Invalid field, if any
+	Reason       string // Machine readable cause, if any. e.g ReasonVersionMismatch
+	Message      string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func NewNotFoundError(resourceType string, resourceName interface{}) *Error {
+	name := fmt.Sprint(resourceName)
+	return &Error{Code: ErrCodeNotFound, ResourceType: resourceType, ResourceName: name,
+		Message: fmt.Sprintf("%s %s not found", resourceType, name)}
+}
+
+func NewConflictError(resourceType string, resourceName interface{}, msg string) *Error {
+	return &Error{Code: ErrCodeConflict, ResourceType: resourceType, ResourceName: fmt.Sprint(resourceName), Message: msg}
+}
+
+func NewInvalidError(resourceType string, field string, msg string) *Error {
+	return &Error{Code: ErrCodeInvalid, ResourceType: resourceType, Field: field, Message: msg}
+}
+
+func NewPermissionDeniedError(resourceType string, resourceName interface{}, msg string) *Error {
+	return &Error{Code: ErrCodePermissionDenied, ResourceType: resourceType, ResourceName: fmt.Sprint(resourceName), Message: msg}
+}
+
+func NewAbortedError(resourceType string, resourceName interface{}, msg string) *Error {
+	return &Error{Code: ErrCodeAborted, ResourceType: resourceType, ResourceName: fmt.Sprint(resourceName), Message: msg}
+}
+
+func NewFailedPreconditionError(resourceType string, resourceName interface{}, msg string) *Error {
+	return &Error{Code: ErrCodeFailedPrecondition, ResourceType: resourceType, ResourceName: fmt.Sprint(resourceName), Message: msg}
+}
+
+// NewVersionMismatchError is returned when the expected version of a record doesn't match its current one
+func NewVersionMismatchError(resourceType string, resourceName interface{}) *Error {
+	return &Error{Code: ErrCodeFailedPrecondition, ResourceType: resourceType, ResourceName: fmt.Sprint(resourceName), Reason: ReasonVersionMismatch,
+		Message: fmt.Sprintf("%s was modified, expected version does not match", resourceType)}
+}
+
+func NewUnauthenticatedError(resourceType string, msg string) *Error {
+	return &Error{Code: ErrCodeUnauthenticated, ResourceType: resourceType, Message: msg}
+}
+
+func NewRateLimitedError(resourceType string, resourceName interface{}, msg string) *Error {
+	return &Error{Code: ErrCodeRateLimited, ResourceType: resourceType, ResourceName: fmt.Sprint(resourceName), Message: msg}
+}
+
+// ErrorCode returns the code of a domain error, or an empty string for any other error
+func ErrorCode(err error) string {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return ""
+}
+
+// IsNotFound reports whether err is a not-found domain error
+func IsNotFound(err error) bool {
+	return ErrorCode(err) == ErrCodeNotFound
+}
@@ -0,0 +1,33 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+// Credentials of an account
+type Credentials struct {
+	Email string `json:"email" validate:"required,email,lte=254"`
+	// bcrypt ignores anything past 72 bytes
+	Password string `json:"password" validate:"required,gte=8,lte=72"`
+}
+
+// Tokens are issued on login. The access token is a JWT accepted by the auth interceptors,
+// the refresh token is exchanged for new tokens once it expires
+type Tokens struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"` // Of the access token
+}
+
+type IdentityService interface {
+	Register(c context.Context, cred *Credentials) (*Account, error)
+	// Login fails with ErrInvalidCredentials for both an unknown email and a wrong password
+	Login(c context.Context, cred *Credentials) (*Tokens, error)
+	// ChangePassword revokes the refresh tokens issued so far
+	ChangePassword(c context.Context, accountID uint, oldPassword string, newPassword string) error
+	RefreshToken(c context.Context, refreshToken string) (*Tokens, error)
+}
+
+// ErrInvalidCredentials is returned when the email or the password are wrong, or a refresh token isn't valid
+var ErrInvalidCredentials = NewUnauthenticatedError(AccountResource, "invalid credentials")
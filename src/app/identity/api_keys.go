@@ -0,0 +1,182 @@
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/pkg/validator"
+)
+
+// API keys look like bpk_<prefix>_<secret>, the marker helps secret scanners spot leaked keys
+const (
+	apiKeyMarker       = "bpk_"
+	apiKeyPrefixBytes  = 8
+	apiKeySecretBytes  = 32
+	apiKeyLength       = len(apiKeyMarker) + apiKeyPrefixBytes*2 + 1 + apiKeySecretBytes*2
+	apiKeyFlushTimeout = 5 * time.Second
+)
+
+// NewAPIKeyManager creates an APIKeyManager over the keys store. Keys are random enough for a fast hash,
+// they are hashed using HMAC-SHA256 keyed by hashKey. Changing hashKey invalidates every key
+func NewAPIKeyManager(keys app.APIKeyService, sv validator.StructValidator, hashKey []byte) *APIKeyManager {
+	return &APIKeyManager{keys: keys, sv: sv, hashKey: hashKey, lastUsed: map[uint]time.Time{}}
+}
+
+// APIKeyManager issues and verifies API keys. The plain key is returned once, on creation and rotation
+type APIKeyManager struct {
+	keys    app.APIKeyService
+	sv      validator.StructValidator
+	hashKey []byte
+
+	mu       sync.Mutex
+	lastUsed map[uint]time.Time // Not written yet, see Run
+}
+
+// Create issues a new key, with k's name, scopes, roles and expiry
+func (m *APIKeyManager) Create(c context.Context, k *app.APIKey) (*app.APIKey, string, error) {
+	if err := m.sv.StructCtx(c, k); err != nil {
+		return nil, "", err
+	}
+	if k.ExpiresAt != nil && !k.ExpiresAt.After(time.Now()) {
+		return nil, "", app.NewInvalidError(app.APIKeyResource, "expires_at", "expiry must be in the future")
+	}
+
+	prefix, key, err := newAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	k = &app.APIKey{
+		Name:      k.Name,
+		Prefix:    prefix,
+		KeyHash:   m.hash(key),
+		Scopes:    k.Scopes,
+		Roles:     k.Roles,
+		ExpiresAt: k.ExpiresAt,
+	}
+	if k, err = m.keys.Create(c, k); err != nil {
+		return nil, "", err
+	}
+
+	return k, key, nil
+}
+
+// Rotate replaces the key of id, the previous key stops working right away
+func (m *APIKeyManager) Rotate(c context.Context, id uint) (*app.APIKey, string, error) {
+	prefix, key, err := newAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	k, err := m.keys.SetSecret(c, id, prefix, m.hash(key))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return k, key, nil
+}
+
+func (m *APIKeyManager) Revoke(c context.Context, id uint) error {
+	return m.keys.Revoke(c, id)
+}
+
+// Verify returns the API key of a plain key, ErrInvalidCredentials if it's unknown, revoked or expired.
+// Its use is recorded, see Run
+func (m *APIKeyManager) Verify(c context.Context, key string) (*app.APIKey, error) {
+	if len(key) != apiKeyLength || !strings.HasPrefix(key, apiKeyMarker) {
+		return nil, app.ErrInvalidCredentials
+	}
+
+	k, err := m.keys.GetByPrefix(c, key[len(apiKeyMarker):len(apiKeyMarker)+apiKeyPrefixBytes*2])
+	if app.IsNotFound(err) {
+		return nil, app.ErrInvalidCredentials
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal([]byte(k.KeyHash), []byte(m.hash(key))) {
+		return nil, app.ErrInvalidCredentials
+	}
+	if k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt) {
+		return nil, app.NewUnauthenticatedError(app.APIKeyResource, "API key expired")
+	}
+
+	m.mu.Lock()
+	m.lastUsed[k.ID] = time.Now()
+	m.mu.Unlock()
+
+	return k, nil
+}
+
+// Run writes the last used time of verified keys every interval, until c is done; then it writes the pending ones.
+// Failures are passed to onError, the times are retried on the next run
+func (m *APIKeyManager) Run(c context.Context, interval time.Duration, onError func(err error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), apiKeyFlushTimeout)
+			defer cancel()
+			if err := m.flush(flushCtx); err != nil {
+				onError(err)
+			}
+			return
+		case <-ticker.C:
+		}
+
+		if err := m.flush(c); err != nil {
+			onError(err)
+		}
+	}
+}
+
+func (m *APIKeyManager) flush(c context.Context) error {
+	m.mu.Lock()
+	lastUsed := m.lastUsed
+	m.lastUsed = map[uint]time.Time{}
+	m.mu.Unlock()
+
+	if len(lastUsed) == 0 {
+		return nil
+	}
+
+	err := m.keys.TouchLastUsed(c, lastUsed)
+	if err != nil {
+		// Put them back, unless the keys were used again in the meantime
+		m.mu.Lock()
+		for id, t := range lastUsed {
+			if _, ok := m.lastUsed[id]; !ok {
+				m.lastUsed[id] = t
+			}
+		}
+		m.mu.Unlock()
+	}
+
+	return err
+}
+
+func (m *APIKeyManager) hash(key string) string {
+	mac := hmac.New(sha256.New, m.hashKey)
+	mac.Write([]byte(key))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// newAPIKey generates a random key, along with its prefix
+func newAPIKey() (prefix string, key string, err error) {
+	b := make([]byte, apiKeyPrefixBytes+apiKeySecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	prefix = hex.EncodeToString(b[:apiKeyPrefixBytes])
+	return prefix, apiKeyMarker + prefix + "_" + hex.EncodeToString(b[apiKeyPrefixBytes:]), nil
+}
@@ -0,0 +1,129 @@
+package identity
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/app/memory"
+	v10validator "github.com/go-playground/validator/v10"
+)
+
+func newAPIKeyManager() *APIKeyManager {
+	return NewAPIKeyManager(memory.NewAPIKeyService(), v10validator.New(), []byte("secret"))
+}
+
+func TestAPIKeyManager_Verify(t *testing.T) {
+	tests := []struct {
+		name string
+		// key returns the plain key to verify, given the one issued by m
+		key         func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string
+		wantErrCode string
+	}{
+		{name: "valid", key: func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string {
+			return key
+		}},
+		{name: "wrong secret", wantErrCode: app.ErrCodeUnauthenticated, key: func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string {
+			last := "0"
+			if strings.HasSuffix(key, last) {
+				last = "1"
+			}
+			return key[:len(key)-1] + last
+		}},
+		{name: "unknown prefix", wantErrCode: app.ErrCodeUnauthenticated, key: func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string {
+			return apiKeyMarker + strings.Repeat("0", apiKeyPrefixBytes*2) + key[len(apiKeyMarker)+apiKeyPrefixBytes*2:]
+		}},
+		{name: "too short", wantErrCode: app.ErrCodeUnauthenticated, key: func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string {
+			return key[:len(key)-1]
+		}},
+		{name: "no marker", wantErrCode: app.ErrCodeUnauthenticated, key: func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string {
+			return "xyz_" + key[len(apiKeyMarker):]
+		}},
+		{name: "other hash key", wantErrCode: app.ErrCodeUnauthenticated, key: func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string {
+			m.hashKey = []byte("other")
+			return key
+		}},
+		{name: "revoked", wantErrCode: app.ErrCodeUnauthenticated, key: func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string {
+			if err := m.Revoke(c, k.ID); err != nil {
+				t.Fatal(err)
+			}
+			return key
+		}},
+		{name: "expired", wantErrCode: app.ErrCodeUnauthenticated, key: func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string {
+			// Create doesn't take expired keys, store one directly
+			prefix, key, err := newAPIKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			expiresAt := time.Now().Add(-time.Minute)
+			if _, err := m.keys.Create(c, &app.APIKey{Name: "expired", Prefix: prefix, KeyHash: m.hash(key), ExpiresAt: &expiresAt}); err != nil {
+				t.Fatal(err)
+			}
+			return key
+		}},
+		{name: "rotated, previous key", wantErrCode: app.ErrCodeUnauthenticated, key: func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string {
+			if _, _, err := m.Rotate(c, k.ID); err != nil {
+				t.Fatal(err)
+			}
+			return key
+		}},
+		{name: "rotated, new key", key: func(t *testing.T, c context.Context, m *APIKeyManager, k *app.APIKey, key string) string {
+			rotated, key, err := m.Rotate(c, k.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rotated.ID != k.ID || rotated.Prefix == k.Prefix {
+				t.Fatalf("expected key %d to get a new prefix, got %+v", k.ID, rotated)
+			}
+			return key
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newAPIKeyManager()
+
+			c := app.WithTenant(context.Background(), "acme")
+			expiresAt := time.Now().Add(time.Hour)
+			k, key, err := m.Create(c, &app.APIKey{Name: "ci", Scopes: []string{"visits:read"}, ExpiresAt: &expiresAt})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := m.Verify(c, tt.key(t, c, m, k, key))
+			if code := app.ErrorCode(err); code != tt.wantErrCode {
+				t.Fatalf("expected error code %q, got %v", tt.wantErrCode, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got.ID != k.ID || got.TenantID != "acme" {
+				t.Errorf("unexpected API key %+v", got)
+			}
+
+			// The use is recorded once flushed
+			if err := m.flush(c); err != nil {
+				t.Fatal(err)
+			}
+			if got, err = m.keys.Get(c, k.ID); err != nil {
+				t.Fatal(err)
+			}
+			if got.LastUsedAt == nil {
+				t.Error("expected the last used time to be recorded")
+			}
+		})
+	}
+}
+
+func TestAPIKeyManager_Create_Expired(t *testing.T) {
+	m := newAPIKeyManager()
+
+	c := app.WithTenant(context.Background(), "acme")
+	expiresAt := time.Now().Add(-time.Minute)
+	if _, _, err := m.Create(c, &app.APIKey{Name: "ci", ExpiresAt: &expiresAt}); app.ErrorCode(err) != app.ErrCodeInvalid {
+		t.Fatalf("expected error code %q, got %v", app.ErrCodeInvalid, err)
+	}
+}
@@ -0,0 +1,175 @@
+package identity
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/pkg/crypto"
+	"github.com/eldad87/go-boilerplate/src/pkg/validator"
+)
+
+// RegistrationConfig controls self-registration. The tenant of a request is picked by the client,
+// so registration is closed unless the tenant opts in
+type RegistrationConfig struct {
+	Tenants      []string // Tenants that allow self-registration, "*" for any
+	DefaultRoles []string // Of registered accounts
+}
+
+func (rc RegistrationConfig) allowed(tenantID string) bool {
+	for _, t := range rc.Tenants {
+		if t == "*" || t == tenantID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewIdentityService creates an IdentityService over the accounts store.
+// Registration is controlled by registration, failed logins are throttled by limiter
+func NewIdentityService(accounts app.AccountService, sv validator.StructValidator, ph crypto.PasswordHandler,
+	tokens *TokenIssuer, limiter *LoginLimiter, registration RegistrationConfig) *identityService {
+	return &identityService{accounts: accounts, sv: sv, ph: ph, tokens: tokens, limiter: limiter, registration: registration}
+}
+
+type identityService struct {
+	accounts     app.AccountService
+	sv           validator.StructValidator
+	ph           crypto.PasswordHandler
+	tokens       *TokenIssuer
+	limiter      *LoginLimiter
+	registration RegistrationConfig
+
+	// dummyHash is compared against on unknown emails, so they take as long as wrong passwords
+	dummyHashOnce sync.Once
+	dummyHash     string
+}
+
+func (is *identityService) Register(c context.Context, cred *app.Credentials) (*app.Account, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	if !is.registration.allowed(tenantID) {
+		return nil, app.NewPermissionDeniedError(app.AccountResource, tenantID, "registration is closed")
+	}
+
+	cred = &app.Credentials{Email: normalizeEmail(cred.Email), Password: cred.Password}
+	if err := is.sv.StructCtx(c, cred); err != nil {
+		return nil, err
+	}
+
+	hash, err := is.ph.Generate(cred.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return is.accounts.Create(c, &app.Account{Email: cred.Email, PasswordHash: hash, Roles: is.registration.DefaultRoles})
+}
+
+func (is *identityService) Login(c context.Context, cred *app.Credentials) (*app.Tokens, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	email := normalizeEmail(cred.Email)
+	// The attempt is reserved, it counts as a failure unless the password matches
+	key := "login\x00" + tenantID + "\x00" + email
+	if !is.limiter.Allow(key) {
+		return nil, app.NewRateLimitedError(app.AccountResource, email, "too many failed logins, try again later")
+	}
+
+	a, err := is.accounts.GetByEmail(c, email)
+	if app.IsNotFound(err) {
+		is.ph.Compare(is.getDummyHash(), cred.Password)
+		return nil, app.ErrInvalidCredentials
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := is.ph.Compare(a.PasswordHash, cred.Password); err != nil {
+		return nil, app.ErrInvalidCredentials
+	}
+	is.limiter.Reset(key)
+
+	return is.tokens.Issue(a)
+}
+
+func (is *identityService) ChangePassword(c context.Context, accountID uint, oldPassword string, newPassword string) error {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	// Throttled as well, a stolen access token mustn't be enough to guess the password
+	key := "password\x00" + tenantID + "\x00" + strconv.FormatUint(uint64(accountID), 10)
+	if !is.limiter.Allow(key) {
+		return app.NewRateLimitedError(app.AccountResource, accountID, "too many failed attempts, try again later")
+	}
+
+	a, err := is.accounts.Get(c, accountID)
+	if err != nil {
+		return err
+	}
+
+	if err := is.ph.Compare(a.PasswordHash, oldPassword); err != nil {
+		return app.ErrInvalidCredentials
+	}
+	is.limiter.Reset(key)
+
+	cred := &app.Credentials{Password: newPassword}
+	if err := is.sv.StructPartialCtx(c, cred, "Password"); err != nil {
+		return err
+	}
+
+	hash, err := is.ph.Generate(newPassword)
+	if err != nil {
+		return err
+	}
+
+	_, err = is.accounts.SetPasswordHash(c, accountID, hash)
+	return err
+}
+
+func (is *identityService) RefreshToken(c context.Context, refreshToken string) (*app.Tokens, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := is.tokens.parseRefresh(refreshToken)
+	if err != nil || claims.TenantID != tenantID {
+		return nil, app.ErrInvalidCredentials
+	}
+
+	a, err := is.accounts.Get(c, claims.AccountID)
+	if app.IsNotFound(err) {
+		return nil, app.ErrInvalidCredentials
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Revoked by a password change
+	if a.TokenVersion != claims.TokenVersion {
+		return nil, app.ErrInvalidCredentials
+	}
+
+	return is.tokens.Issue(a)
+}
+
+func (is *identityService) getDummyHash() string {
+	is.dummyHashOnce.Do(func() {
+		is.dummyHash, _ = is.ph.Generate("dummy password")
+	})
+
+	return is.dummyHash
+}
+
+// normalizeEmail makes emails case insensitive
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
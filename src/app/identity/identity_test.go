@@ -0,0 +1,128 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/app/memory"
+	v10validator "github.com/go-playground/validator/v10"
+)
+
+// slowPasswordHandler compares plain passwords, as slow as configured to widen races
+type slowPasswordHandler struct {
+	delay    time.Duration
+	compares int32
+}
+
+func (ph *slowPasswordHandler) Generate(s string) (string, error) {
+	return "hash:" + s, nil
+}
+
+func (ph *slowPasswordHandler) Compare(hash string, s string) error {
+	atomic.AddInt32(&ph.compares, 1)
+	time.Sleep(ph.delay)
+	if hash != "hash:"+s {
+		return errors.New("mismatch")
+	}
+	return nil
+}
+
+func newIdentityService(ph *slowPasswordHandler, maxFailures int, registration RegistrationConfig) *identityService {
+	tokens := NewTokenIssuer(TokenConfig{Secret: []byte("secret"), AccessTTL: time.Minute, RefreshTTL: time.Hour})
+	return NewIdentityService(memory.NewAccountService(), v10validator.New(), ph, tokens, NewLoginLimiter(maxFailures, time.Minute), registration)
+}
+
+func TestIdentityService_Register(t *testing.T) {
+	tests := []struct {
+		name         string
+		registration RegistrationConfig
+		tenantID     string
+		wantErrCode  string
+		wantRoles    []string
+	}{
+		{name: "closed by default", tenantID: "acme", wantErrCode: app.ErrCodePermissionDenied},
+		{name: "other tenant", registration: RegistrationConfig{Tenants: []string{"globex"}}, tenantID: "acme", wantErrCode: app.ErrCodePermissionDenied},
+		{name: "tenant opted in", registration: RegistrationConfig{Tenants: []string{"globex", "acme"}}, tenantID: "acme"},
+		{name: "any tenant", registration: RegistrationConfig{Tenants: []string{"*"}}, tenantID: "acme"},
+		{name: "default roles", registration: RegistrationConfig{Tenants: []string{"acme"}, DefaultRoles: []string{"viewer"}}, tenantID: "acme", wantRoles: []string{"viewer"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			is := newIdentityService(&slowPasswordHandler{}, 5, tt.registration)
+
+			c := app.WithTenant(context.Background(), tt.tenantID)
+			a, err := is.Register(c, &app.Credentials{Email: "John@example.com", Password: "12345678"})
+			if code := app.ErrorCode(err); code != tt.wantErrCode {
+				t.Fatalf("expected error code %q, got %v", tt.wantErrCode, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if a.Email != "john@example.com" || a.TenantID != tt.tenantID {
+				t.Errorf("unexpected account %+v", a)
+			}
+			if !reflect.DeepEqual(a.Roles, tt.wantRoles) {
+				t.Errorf("expected roles %v, got %v", tt.wantRoles, a.Roles)
+			}
+		})
+	}
+}
+
+func TestIdentityService_Login_Throttled(t *testing.T) {
+	const maxFailures = 3
+
+	ph := &slowPasswordHandler{delay: 20 * time.Millisecond}
+	is := newIdentityService(ph, maxFailures, RegistrationConfig{Tenants: []string{"*"}})
+
+	c := app.WithTenant(context.Background(), "acme")
+	if _, err := is.Register(c, &app.Credentials{Email: "john@example.com", Password: "12345678"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Concurrent guesses can't get past the limit while their passwords are compared
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			is.Login(c, &app.Credentials{Email: "john@example.com", Password: "wrong password"})
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&ph.compares); n != maxFailures {
+		t.Fatalf("expected %d passwords to be compared, got %d", maxFailures, n)
+	}
+
+	_, err := is.Login(c, &app.Credentials{Email: "john@example.com", Password: "12345678"})
+	if code := app.ErrorCode(err); code != app.ErrCodeRateLimited {
+		t.Fatalf("expected the account to be locked out, got %v", err)
+	}
+}
+
+func TestIdentityService_Login_ResetOnSuccess(t *testing.T) {
+	is := newIdentityService(&slowPasswordHandler{}, 2, RegistrationConfig{Tenants: []string{"*"}})
+
+	c := app.WithTenant(context.Background(), "acme")
+	if _, err := is.Register(c, &app.Credentials{Email: "john@example.com", Password: "12345678"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A successful login forgets the failures before it, the limit is never reached
+	for _, password := range []string{"wrong password", "12345678", "wrong password", "12345678"} {
+		_, err := is.Login(c, &app.Credentials{Email: "john@example.com", Password: password})
+		if password == "12345678" && err != nil {
+			t.Fatalf("expected to log in, got %v", err)
+		} else if password != "12345678" && err != app.ErrInvalidCredentials {
+			t.Fatalf("expected invalid credentials, got %v", err)
+		}
+	}
+}
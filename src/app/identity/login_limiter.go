@@ -0,0 +1,70 @@
+package identity
+
+import (
+	"sync"
+	"time"
+)
+
+// NewLoginLimiter creates a LoginLimiter that locks a key out once it attempted maxFailures times within window
+// without succeeding, until the window of its first attempt is over
+func NewLoginLimiter(maxFailures int, window time.Duration) *LoginLimiter {
+	return &LoginLimiter{maxFailures: maxFailures, window: window, failures: map[string]*loginFailures{}}
+}
+
+// LoginLimiter throttles password guessing. It's kept in memory, each instance counts its own failures
+type LoginLimiter struct {
+	maxFailures int
+	window      time.Duration
+
+	mu        sync.Mutex
+	failures  map[string]*loginFailures
+	lastSweep time.Time
+}
+
+type loginFailures struct {
+	count int
+	since time.Time
+}
+
+// Allow reports whether key may attempt to log in, and if so reserves an attempt.
+// The attempt counts as a failure until Reset, concurrent attempts can't exceed the limit while they're being checked
+func (l *LoginLimiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.sweep(now)
+
+	f, ok := l.failures[key]
+	if !ok || now.Sub(f.since) > l.window {
+		f = &loginFailures{since: now}
+		l.failures[key] = f
+	}
+	if f.count >= l.maxFailures {
+		return false
+	}
+
+	f.count++
+	return true
+}
+
+// Reset forgets the attempts of key, e.g once it logs in
+func (l *LoginLimiter) Reset(key string) {
+	l.mu.Lock()
+	delete(l.failures, key)
+	l.mu.Unlock()
+}
+
+// sweep drops expired failures, at most once per window. Must be called with the lock held
+func (l *LoginLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.window {
+		return
+	}
+
+	for key, f := range l.failures {
+		if now.Sub(f.since) > l.window {
+			delete(l.failures, key)
+		}
+	}
+	l.lastSweep = now
+}
@@ -0,0 +1,46 @@
+package identity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoginLimiter(t *testing.T) {
+	type step struct {
+		reset bool // Reset instead of Allow
+		sleep time.Duration
+		want  bool
+	}
+
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{name: "attempts up to the limit", steps: []step{{want: true}, {want: true}, {want: true}, {want: false}}},
+		{name: "reset on success", steps: []step{{want: true}, {want: true}, {reset: true}, {want: true}, {want: true}, {want: true}, {want: false}}},
+		{name: "window is over", steps: []step{{want: true}, {want: true}, {want: true}, {want: false}, {sleep: 60 * time.Millisecond, want: true}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLoginLimiter(3, 50*time.Millisecond)
+
+			for i, s := range tt.steps {
+				time.Sleep(s.sleep)
+				if s.reset {
+					l.Reset("key")
+					continue
+				}
+
+				if got := l.Allow("key"); got != s.want {
+					t.Fatalf("step %d: expected Allow %v, got %v", i, s.want, got)
+				}
+			}
+
+			// Other keys are counted on their own
+			if !l.Allow("other") {
+				t.Error("expected another key to be allowed")
+			}
+		})
+	}
+}
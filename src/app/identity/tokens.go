@@ -0,0 +1,127 @@
+package identity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// refreshTokenType is the typ claim of refresh tokens
+const refreshTokenType = "refresh"
+
+// TokenConfig sets the tokens issued on login. Access tokens are HS256 JWTs accepted by the auth interceptors,
+// so Secret, Issuer and Audience must match auth.JWTConfig
+type TokenConfig struct {
+	Secret     []byte
+	Issuer     string // Optional
+	Audience   string // Optional
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	Scopes     []string // Granted to every access token
+}
+
+// NewTokenIssuer creates a TokenIssuer. Refresh tokens are signed using a key derived from the secret,
+// so the auth interceptors never accept them as access tokens
+func NewTokenIssuer(conf TokenConfig) *TokenIssuer {
+	mac := hmac.New(sha256.New, conf.Secret)
+	mac.Write([]byte(refreshTokenType))
+
+	return &TokenIssuer{
+		conf:       conf,
+		refreshKey: mac.Sum(nil),
+		parser:     &jwt.Parser{ValidMethods: []string{jwt.SigningMethodHS256.Alg()}},
+	}
+}
+
+type TokenIssuer struct {
+	conf       TokenConfig
+	refreshKey []byte
+	parser     *jwt.Parser
+}
+
+// refreshClaims are the claims of a refresh token we rely on
+type refreshClaims struct {
+	AccountID    uint
+	TenantID     string
+	TokenVersion uint
+}
+
+// Issue creates a new pair of tokens for a
+func (ti *TokenIssuer) Issue(a *app.Account) (*app.Tokens, error) {
+	now := time.Now()
+	sub := strconv.FormatUint(uint64(a.ID), 10)
+
+	access := jwt.MapClaims{
+		"sub":   sub,
+		"tid":   a.TenantID,
+		"scope": strings.Join(ti.conf.Scopes, " "),
+		"roles": a.Roles,
+		"iat":   now.Unix(),
+		"exp":   now.Add(ti.conf.AccessTTL).Unix(),
+	}
+	refresh := jwt.MapClaims{
+		"typ": refreshTokenType,
+		"sub": sub,
+		"tid": a.TenantID,
+		"ver": a.TokenVersion,
+		"iat": now.Unix(),
+		"exp": now.Add(ti.conf.RefreshTTL).Unix(),
+	}
+	for _, claims := range []jwt.MapClaims{access, refresh} {
+		if ti.conf.Issuer != "" {
+			claims["iss"] = ti.conf.Issuer
+		}
+		if ti.conf.Audience != "" {
+			claims["aud"] = ti.conf.Audience
+		}
+	}
+
+	tokens := &app.Tokens{ExpiresAt: now.Add(ti.conf.AccessTTL)}
+	var err error
+	if tokens.AccessToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, access).SignedString(ti.conf.Secret); err != nil {
+		return nil, err
+	}
+	if tokens.RefreshToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, refresh).SignedString(ti.refreshKey); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// parseRefresh verifies a refresh token, expired ones are rejected
+func (ti *TokenIssuer) parseRefresh(raw string) (*refreshClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := ti.parser.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		return ti.refreshKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("token has no expiration")
+	}
+	if typ, _ := claims["typ"].(string); typ != refreshTokenType {
+		return nil, errors.New("not a refresh token")
+	}
+	if ti.conf.Issuer != "" && !claims.VerifyIssuer(ti.conf.Issuer, true) {
+		return nil, errors.New("unexpected token issuer")
+	}
+
+	sub, _ := claims["sub"].(string)
+	id, err := strconv.ParseUint(sub, 10, 0)
+	if err != nil {
+		return nil, errors.New("invalid token subject")
+	}
+
+	tenantID, _ := claims["tid"].(string)
+	version, _ := claims["ver"].(float64)
+
+	return &refreshClaims{AccountID: uint(id), TenantID: tenantID, TokenVersion: uint(version)}, nil
+}
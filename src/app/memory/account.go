@@ -0,0 +1,121 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+)
+
+// NewAccountService creates an AccountService that keeps accounts in memory
+func NewAccountService() *accountService {
+	return &accountService{accounts: map[uint]*app.Account{}}
+}
+
+type accountService struct {
+	mu       sync.RWMutex
+	accounts map[uint]*app.Account
+	lastID   uint
+}
+
+func (as *accountService) Get(c context.Context, id uint) (*app.Account, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	as.mu.RLock()
+	defer as.mu.RUnlock()
+
+	a, ok := as.accounts[id]
+	if !ok || a.TenantID != tenantID {
+		return nil, app.NewNotFoundError(app.AccountResource, id)
+	}
+
+	return copyAccount(a), nil
+}
+
+func (as *accountService) GetByEmail(c context.Context, email string) (*app.Account, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	as.mu.RLock()
+	defer as.mu.RUnlock()
+
+	a, ok := as.findByEmail(tenantID, email)
+	if !ok {
+		return nil, app.NewNotFoundError(app.AccountResource, email)
+	}
+
+	return copyAccount(a), nil
+}
+
+func (as *accountService) Create(c context.Context, a *app.Account) (*app.Account, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	as.mu.Lock()
+	defer as.mu.Unlock()
+
+	if _, ok := as.findByEmail(tenantID, a.Email); ok {
+		return nil, app.NewConflictError(app.AccountResource, a.Email, "email is already registered")
+	}
+
+	as.lastID++
+	now := time.Now()
+	a = &app.Account{
+		ID:           as.lastID,
+		Email:        a.Email,
+		PasswordHash: a.PasswordHash,
+		Roles:        append([]string(nil), a.Roles...),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		TenantID:     tenantID,
+	}
+	as.accounts[a.ID] = a
+
+	return copyAccount(a), nil
+}
+
+func (as *accountService) SetPasswordHash(c context.Context, id uint, passwordHash string) (*app.Account, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	as.mu.Lock()
+	defer as.mu.Unlock()
+
+	a, ok := as.accounts[id]
+	if !ok || a.TenantID != tenantID {
+		return nil, app.NewNotFoundError(app.AccountResource, id)
+	}
+
+	a.PasswordHash = passwordHash
+	a.TokenVersion++
+	a.UpdatedAt = time.Now()
+
+	return copyAccount(a), nil
+}
+
+// findByEmail must be called with the lock held
+func (as *accountService) findByEmail(tenantID string, email string) (*app.Account, bool) {
+	for _, a := range as.accounts {
+		if a.TenantID == tenantID && a.Email == email {
+			return a, true
+		}
+	}
+
+	return nil, false
+}
+
+func copyAccount(a *app.Account) *app.Account {
+	cp := *a
+	cp.Roles = append([]string(nil), a.Roles...)
+	return &cp
+}
@@ -0,0 +1,157 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+)
+
+// NewAPIKeyService creates an APIKeyService that keeps API keys in memory
+func NewAPIKeyService() *apiKeyService {
+	return &apiKeyService{keys: map[uint]*app.APIKey{}}
+}
+
+type apiKeyService struct {
+	mu     sync.RWMutex
+	keys   map[uint]*app.APIKey // Revoked keys are removed
+	lastID uint
+}
+
+func (ks *apiKeyService) Get(c context.Context, id uint) (*app.APIKey, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	k, ok := ks.keys[id]
+	if !ok || k.TenantID != tenantID {
+		return nil, app.NewNotFoundError(app.APIKeyResource, id)
+	}
+
+	return copyAPIKey(k), nil
+}
+
+func (ks *apiKeyService) GetByPrefix(c context.Context, prefix string) (*app.APIKey, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	k, ok := ks.findByPrefix(prefix)
+	if !ok {
+		return nil, app.NewNotFoundError(app.APIKeyResource, prefix)
+	}
+
+	return copyAPIKey(k), nil
+}
+
+func (ks *apiKeyService) Create(c context.Context, k *app.APIKey) (*app.APIKey, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if _, ok := ks.findByPrefix(k.Prefix); ok {
+		return nil, app.NewConflictError(app.APIKeyResource, k.Prefix, "API key prefix is already taken")
+	}
+
+	ks.lastID++
+	now := time.Now()
+	k = copyAPIKey(k)
+	k.ID = ks.lastID
+	k.LastUsedAt = nil
+	k.CreatedAt = now
+	k.UpdatedAt = now
+	k.TenantID = tenantID
+	ks.keys[k.ID] = k
+
+	return copyAPIKey(k), nil
+}
+
+func (ks *apiKeyService) SetSecret(c context.Context, id uint, prefix string, keyHash string) (*app.APIKey, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	k, ok := ks.keys[id]
+	if !ok || k.TenantID != tenantID {
+		return nil, app.NewNotFoundError(app.APIKeyResource, id)
+	}
+	if other, ok := ks.findByPrefix(prefix); ok && other.ID != id {
+		return nil, app.NewConflictError(app.APIKeyResource, prefix, "API key prefix is already taken")
+	}
+
+	k.Prefix = prefix
+	k.KeyHash = keyHash
+	k.UpdatedAt = time.Now()
+
+	return copyAPIKey(k), nil
+}
+
+func (ks *apiKeyService) Revoke(c context.Context, id uint) error {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	k, ok := ks.keys[id]
+	if !ok || k.TenantID != tenantID {
+		return app.NewNotFoundError(app.APIKeyResource, id)
+	}
+	delete(ks.keys, id)
+
+	return nil
+}
+
+func (ks *apiKeyService) TouchLastUsed(c context.Context, lastUsed map[uint]time.Time) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	for id, t := range lastUsed {
+		if k, ok := ks.keys[id]; ok {
+			t := t
+			k.LastUsedAt = &t
+		}
+	}
+
+	return nil
+}
+
+// findByPrefix must be called with the lock held
+func (ks *apiKeyService) findByPrefix(prefix string) (*app.APIKey, bool) {
+	for _, k := range ks.keys {
+		if k.Prefix == prefix {
+			return k, true
+		}
+	}
+
+	return nil, false
+}
+
+func copyAPIKey(k *app.APIKey) *app.APIKey {
+	cp := *k
+	cp.Scopes = append([]string(nil), k.Scopes...)
+	cp.Roles = append([]string(nil), k.Roles...)
+	if k.ExpiresAt != nil {
+		expiresAt := *k.ExpiresAt
+		cp.ExpiresAt = &expiresAt
+	}
+	if k.LastUsedAt != nil {
+		lastUsedAt := *k.LastUsedAt
+		cp.LastUsedAt = &lastUsedAt
+	}
+	return &cp
+}
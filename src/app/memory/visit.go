@@ -0,0 +1,507 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/pkg/validator"
+)
+
+// NewVisitService creates a VisitService that keeps visits in memory, e.g for tests or running without a database
+func NewVisitService(sv validator.StructValidator, events *app.VisitEvents) *visitService {
+	return &visitService{sv: sv, events: events, visits: map[uint]*visitRecord{}, audits: map[uint][]*app.VisitAudit{}}
+}
+
+type visitService struct {
+	sv     validator.StructValidator
+	events *app.VisitEvents
+
+	mu          sync.RWMutex
+	visits      map[uint]*visitRecord
+	lastID      uint
+	audits      map[uint][]*app.VisitAudit
+	lastAuditID uint64
+}
+
+type visitRecord struct {
+	visit     app.Visit
+	deletedAt *time.Time
+}
+
+func (vs *visitService) Get(c context.Context, id *uint) (*app.Visit, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	vs.mu.RLock()
+	defer vs.mu.RUnlock()
+
+	r, ok := vs.find(tenantID, *id)
+	if !ok {
+		return nil, app.NewNotFoundError(app.VisitResource, *id)
+	}
+
+	return r.copy(), nil
+}
+
+func (vs *visitService) Create(c context.Context, v *app.Visit) (*app.Visit, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vs.sv.StructCtx(c, v)
+	if err != nil {
+		return nil, err
+	}
+
+	vs.mu.Lock()
+	r := vs.create(tenantID, v)
+	audit, err := newAudit(c, app.VisitAuditCreated, r.visit.ID, nil, &r.visit)
+	if err != nil {
+		vs.lastID--
+		vs.mu.Unlock()
+		return nil, err
+	}
+	vs.visits[r.visit.ID] = r
+	vs.addAudit(audit)
+	vs.mu.Unlock()
+
+	v = r.copy()
+	vs.events.Publish(app.VisitEventCreated, v)
+	return v, nil
+}
+
+func (vs *visitService) Update(c context.Context, v *app.Visit, fields []string) (*app.Visit, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err = vs.validateUpdate(c, v, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	vs.mu.Lock()
+	r, ok := vs.find(tenantID, v.ID)
+	if !ok {
+		vs.mu.Unlock()
+		return nil, app.NewNotFoundError(app.VisitResource, v.ID)
+	}
+
+	updated, err := vs.update(r, v, fields)
+	if err != nil {
+		vs.mu.Unlock()
+		return nil, err
+	}
+	audit, err := newAudit(c, app.VisitAuditUpdated, r.visit.ID, &r.visit, &updated.visit)
+	if err != nil {
+		vs.mu.Unlock()
+		return nil, err
+	}
+	vs.visits[updated.visit.ID] = updated
+	vs.addAudit(audit)
+	vs.mu.Unlock()
+
+	r = updated
+
+	v = r.copy()
+	vs.events.Publish(app.VisitEventUpdated, v)
+	return v, nil
+}
+
+func (vs *visitService) BatchGet(c context.Context, ids []uint) ([]*app.Visit, error) {
+	if len(ids) > app.VisitBatchMaxSize {
+		return nil, app.NewInvalidError(app.VisitResource, "ids", "too many visits in a single batch")
+	}
+
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	vs.mu.RLock()
+	defer vs.mu.RUnlock()
+
+	// Keep the requested order, skip missing visits
+	visits := make([]*app.Visit, 0, len(ids))
+	for _, id := range ids {
+		if r, ok := vs.find(tenantID, id); ok {
+			visits = append(visits, r.copy())
+		}
+	}
+
+	return visits, nil
+}
+
+func (vs *visitService) BatchSet(c context.Context, visits []*app.Visit, atomic bool) ([]*app.VisitBatchResult, error) {
+	if len(visits) > app.VisitBatchMaxSize {
+		return nil, app.NewInvalidError(app.VisitResource, "visits", "too many visits in a single batch")
+	}
+
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+
+	// Stage the writes, nothing is stored until the whole batch went through
+	lastID := vs.lastID
+	staged := map[uint]*visitRecord{}
+	var audits []*app.VisitAudit
+	find := func(id uint) (*visitRecord, bool) {
+		if r, ok := staged[id]; ok {
+			return r, true
+		}
+		return vs.find(tenantID, id)
+	}
+
+	results := make([]*app.VisitBatchResult, len(visits))
+	for i, v := range visits {
+		result := &app.VisitBatchResult{}
+		var r *visitRecord
+		action := app.VisitAuditCreated
+		var old *app.Visit
+		if v.ID == 0 {
+			if result.Err = vs.sv.StructCtx(c, v); result.Err == nil {
+				r = vs.create(tenantID, v)
+			}
+		} else if current, ok := find(v.ID); !ok {
+			result.Err = app.NewNotFoundError(app.VisitResource, v.ID)
+		} else {
+			action, old = app.VisitAuditUpdated, current.copy()
+			var fields []string
+			if fields, result.Err = vs.validateUpdate(c, v, nil); result.Err == nil {
+				r, result.Err = vs.update(current, v, fields)
+			}
+		}
+
+		// In atomic mode, a single failure discards the whole batch
+		if result.Err != nil && atomic {
+			vs.lastID = lastID
+			return nil, result.Err
+		}
+
+		if result.Err == nil {
+			audit, err := newAudit(c, action, r.visit.ID, old, &r.visit)
+			if err != nil {
+				vs.lastID = lastID
+				return nil, err
+			}
+			audits = append(audits, audit)
+
+			staged[r.visit.ID] = r
+			result.Visit = r.copy()
+		}
+		results[i] = result
+	}
+
+	for id, r := range staged {
+		vs.visits[id] = r
+	}
+	for _, audit := range audits {
+		vs.addAudit(audit)
+	}
+
+	for i, result := range results {
+		if result.Err != nil {
+			continue
+		}
+
+		if visits[i].ID == 0 {
+			vs.events.Publish(app.VisitEventCreated, result.Visit)
+		} else {
+			vs.events.Publish(app.VisitEventUpdated, result.Visit)
+		}
+	}
+
+	return results, nil
+}
+
+// find returns a visit of the tenant that isn't deleted, the caller must hold the lock
+func (vs *visitService) find(tenantID string, id uint) (*visitRecord, bool) {
+	r, ok := vs.visits[id]
+	if !ok || r.deletedAt != nil || r.visit.TenantID != tenantID {
+		return nil, false
+	}
+
+	return r, true
+}
+
+// create builds the record of an already validated visit and assigns its ID, the caller must hold the lock
+func (vs *visitService) create(tenantID string, v *app.Visit) *visitRecord {
+	vs.lastID++
+	now := now()
+
+	return &visitRecord{visit: app.Visit{
+		ID:        vs.lastID,
+		FirstName: v.FirstName,
+		LastName:  v.LastName,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Version:   1,
+		TenantID:  tenantID,
+	}}
+}
+
+// validateUpdate validates the updated fields, all updatable fields are returned if none are given
+func (vs *visitService) validateUpdate(c context.Context, v *app.Visit, fields []string) ([]string, error) {
+	if len(fields) == 0 {
+		fields = []string{app.VisitFieldFirstName, app.VisitFieldLastName}
+	}
+
+	for _, field := range fields {
+		if field != app.VisitFieldFirstName && field != app.VisitFieldLastName {
+			return nil, app.NewInvalidError(app.VisitResource, field, "field "+field+" can't be updated")
+		}
+	}
+
+	return fields, vs.sv.StructPartialCtx(c, v, fields...)
+}
+
+// update returns a new record with the given fields of v written over r
+func (vs *visitService) update(r *visitRecord, v *app.Visit, fields []string) (*visitRecord, error) {
+	if v.Version != 0 && v.Version != r.visit.Version {
+		return nil, app.NewVersionMismatchError(app.VisitResource, v.ID)
+	}
+
+	updated := &visitRecord{visit: r.visit}
+	updated.visit.UpdatedAt = now()
+	updated.visit.Version++
+	for _, field := range fields {
+		switch field {
+		case app.VisitFieldFirstName:
+			updated.visit.FirstName = v.FirstName
+		case app.VisitFieldLastName:
+			updated.visit.LastName = v.LastName
+		}
+	}
+
+	return updated, nil
+}
+
+func (vs *visitService) Delete(c context.Context, id *uint) error {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	vs.mu.Lock()
+	r, ok := vs.find(tenantID, *id)
+	if !ok {
+		vs.mu.Unlock()
+		return app.NewNotFoundError(app.VisitResource, *id)
+	}
+
+	audit, err := newAudit(c, app.VisitAuditDeleted, *id, &r.visit, nil)
+	if err != nil {
+		vs.mu.Unlock()
+		return err
+	}
+
+	// Soft delete, hidden from now on
+	deletedAt := now()
+	vs.visits[*id] = &visitRecord{visit: r.visit, deletedAt: &deletedAt}
+	vs.addAudit(audit)
+	vs.mu.Unlock()
+
+	vs.events.Publish(app.VisitEventDeleted, &app.Visit{ID: *id, TenantID: tenantID})
+	return nil
+}
+
+func (vs *visitService) Purge(c context.Context, id *uint) error {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	vs.mu.Lock()
+	// Hard delete, regardless of deletedAt
+	if r, ok := vs.visits[*id]; !ok || r.visit.TenantID != tenantID {
+		vs.mu.Unlock()
+		return app.NewNotFoundError(app.VisitResource, *id)
+	}
+	delete(vs.visits, *id)
+
+	// Keep the history, without the visit's personal data
+	for _, audit := range vs.audits[*id] {
+		audit.OldValues, audit.NewValues = nil, nil
+	}
+	audit, _ := newAudit(c, app.VisitAuditPurged, *id, nil, nil)
+	vs.addAudit(audit)
+	vs.mu.Unlock()
+
+	vs.events.Publish(app.VisitEventDeleted, &app.Visit{ID: *id, TenantID: tenantID})
+	return nil
+}
+
+func (vs *visitService) Watch(c context.Context, lastEventID uint64) (<-chan *app.VisitEvent, error) {
+	return vs.events.Watch(c, lastEventID)
+}
+
+func (vs *visitService) ListAudit(c context.Context, visitID uint) ([]*app.VisitAudit, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	vs.mu.RLock()
+	defer vs.mu.RUnlock()
+
+	audits := []*app.VisitAudit{}
+	for _, audit := range vs.audits[visitID] {
+		if audit.TenantID == tenantID {
+			a := *audit
+			audits = append(audits, &a)
+		}
+	}
+
+	return audits, nil
+}
+
+// addAudit assigns the entry's ID and stores it, the caller must hold the lock
+func (vs *visitService) addAudit(audit *app.VisitAudit) {
+	vs.lastAuditID++
+	audit.ID = vs.lastAuditID
+	vs.audits[audit.VisitID] = append(vs.audits[audit.VisitID], audit)
+}
+
+// newAudit builds the audit entry of a change, the actor is taken from c
+func newAudit(c context.Context, action string, visitID uint, old *app.Visit, new *app.Visit) (*app.VisitAudit, error) {
+	oldValues, newValues, err := app.VisitDiff(old, new)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &app.VisitAudit{
+		VisitID:   visitID,
+		Action:    action,
+		Actor:     app.ActorFromContext(c),
+		TenantID:  tenantID,
+		OldValues: oldValues,
+		NewValues: newValues,
+		CreatedAt: now(),
+	}, nil
+}
+
+func (vs *visitService) List(c context.Context, f *app.VisitFilter) (*app.VisitPage, error) {
+	err := vs.sv.StructCtx(c, f)
+	if err != nil {
+		return nil, err
+	}
+
+	orderBy := f.OrderBy
+	if orderBy == "" {
+		orderBy = app.VisitOrderByID
+	}
+
+	pageSize := int(f.PageSize)
+	if pageSize == 0 {
+		pageSize = app.VisitListDefaultPageSize
+	}
+
+	// Continue right after the last visit of the previous page
+	var after *app.Visit
+	if f.PageToken != "" {
+		if after, err = app.DecodeVisitPageToken(f.PageToken, orderBy, f.Descending); err != nil {
+			return nil, err
+		}
+	}
+
+	// Direction adjusted comparison, negative if a comes first
+	compare := func(a, b *app.Visit) int {
+		if f.Descending {
+			return compareVisits(orderBy, b, a)
+		}
+		return compareVisits(orderBy, a, b)
+	}
+
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	vs.mu.RLock()
+	visits := []*app.Visit{}
+	for _, r := range vs.visits {
+		v := &r.visit
+		if r.deletedAt != nil || v.TenantID != tenantID ||
+			(f.FirstNamePrefix != "" && !hasPrefix(v.FirstName, f.FirstNamePrefix)) ||
+			(f.LastNamePrefix != "" && !hasPrefix(v.LastName, f.LastNamePrefix)) ||
+			(f.CreatedAfter != nil && v.CreatedAt.Before(*f.CreatedAfter)) ||
+			(f.CreatedBefore != nil && !v.CreatedAt.Before(*f.CreatedBefore)) ||
+			(after != nil && compare(v, after) <= 0) {
+			continue
+		}
+		visits = append(visits, r.copy())
+	}
+	vs.mu.RUnlock()
+
+	sort.Slice(visits, func(i, j int) bool {
+		return compare(visits[i], visits[j]) < 0
+	})
+
+	page := &app.VisitPage{}
+	if len(visits) > pageSize {
+		visits = visits[:pageSize]
+		page.NextPageToken = app.EncodeVisitPageToken(orderBy, f.Descending, visits[pageSize-1])
+	}
+	page.Visits = visits
+
+	return page, nil
+}
+
+func (r *visitRecord) copy() *app.Visit {
+	v := r.visit
+	return &v
+}
+
+// now returns the current time the way MySQL stores it, so both implementations return the same timestamps
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// compareVisits orders visits by orderBy, then by ID. Names are compared case insensitive, like MySQL does
+func compareVisits(orderBy string, a, b *app.Visit) int {
+	var res int
+	switch orderBy {
+	case app.VisitOrderByFirstName:
+		res = strings.Compare(strings.ToLower(a.FirstName), strings.ToLower(b.FirstName))
+	case app.VisitOrderByLastName:
+		res = strings.Compare(strings.ToLower(a.LastName), strings.ToLower(b.LastName))
+	case app.VisitOrderByCreatedAt:
+		if a.CreatedAt.Before(b.CreatedAt) {
+			res = -1
+		} else if a.CreatedAt.After(b.CreatedAt) {
+			res = 1
+		}
+	}
+
+	if res != 0 {
+		return res
+	}
+	if a.ID < b.ID {
+		return -1
+	} else if a.ID > b.ID {
+		return 1
+	}
+	return 0
+}
+
+// hasPrefix is a case insensitive "starts with", like MySQL's LIKE
+func hasPrefix(s string, prefix string) bool {
+	return strings.HasPrefix(strings.ToLower(s), strings.ToLower(prefix))
+}
@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/app/apptest"
+	v10validator "github.com/go-playground/validator/v10"
+)
+
+// newVisitService creates the VisitService of the tests shared by all implementations, see apptest
+func newVisitService(t *testing.T) (app.VisitService, func(t *testing.T, id uint, createdAt time.Time)) {
+	vs := NewVisitService(v10validator.New(), app.NewVisitEvents(10, 10))
+	return vs, func(t *testing.T, id uint, createdAt time.Time) {
+		vs.visits[id].visit.CreatedAt = createdAt
+	}
+}
+
+func TestVisitService_List(t *testing.T) {
+	apptest.VisitServiceList(t, newVisitService)
+}
+
+func TestVisitService_List_InvalidPageToken(t *testing.T) {
+	apptest.VisitServiceListInvalidPageToken(t, newVisitService)
+}
+
+func TestVisitService_Update_Fields(t *testing.T) {
+	apptest.VisitServiceUpdateFields(t, newVisitService)
+}
+
+func TestVisitService_Update_Version(t *testing.T) {
+	apptest.VisitServiceUpdateVersion(t, newVisitService)
+}
+
+func TestVisitService_BatchSet_SameVisit(t *testing.T) {
+	apptest.VisitServiceBatchSetSameVisit(t, newVisitService)
+}
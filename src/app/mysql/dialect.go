@@ -0,0 +1,21 @@
+package mysql
+
+import (
+	"github.com/eldad87/go-boilerplate/src/app/sqlstore"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// Dialect of MySQL, the services are shared by sqlstore.
+// LIKE is case insensitive under the default collation
+var Dialect = &sqlstore.Dialect{
+	Dialect: drivers.Dialect{
+		LQ:              '`',
+		RQ:              '`',
+		UseLastInsertID: true,
+	},
+	Like: "%s LIKE ?",
+	// Other relays wait instead of producing the same tasks
+	ForUpdate:         "UPDATE",
+	IsUniqueViolation: isDuplicateEntry,
+	IsRetryable:       IsRetryable,
+}
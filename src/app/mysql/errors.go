@@ -0,0 +1,24 @@
+package mysql
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQL server error numbers
+const (
+	errDuplicateEntry = 1062
+	errLockDeadlock   = 1213
+)
+
+func isDuplicateEntry(err error) bool {
+	var me *mysql.MySQLError
+	return errors.As(err, &me) && me.Number == errDuplicateEntry
+}
+
+// IsRetryable reports whether a transaction that failed on err may succeed if retried, see app.NewTransactor
+func IsRetryable(err error) bool {
+	var me *mysql.MySQLError
+	return errors.As(err, &me) && me.Number == errLockDeadlock
+}
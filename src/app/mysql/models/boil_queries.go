@@ -1,33 +0,0 @@
-// Code generated by SQLBoiler 4.4.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
-// This file is meant to be re-generated in place and/or deleted at any time.
-
-package models
-
-import (
-	"github.com/volatiletech/sqlboiler/v4/drivers"
-	"github.com/volatiletech/sqlboiler/v4/queries"
-	"github.com/volatiletech/sqlboiler/v4/queries/qm"
-)
-
-var dialect = drivers.Dialect{
-	LQ: 0x60,
-	RQ: 0x60,
-
-	UseIndexPlaceholders:    false,
-	UseLastInsertID:         true,
-	UseSchema:               false,
-	UseDefaultKeyword:       false,
-	UseAutoColumns:          false,
-	UseTopClause:            false,
-	UseOutputClause:         false,
-	UseCaseWhenExistsClause: false,
-}
-
-// NewQuery initializes a new Query using the passed in QueryMods
-func NewQuery(mods ...qm.QueryMod) *queries.Query {
-	q := &queries.Query{}
-	queries.SetDialect(q, &dialect)
-	qm.Apply(q, mods...)
-
-	return q
-}
@@ -1,121 +0,0 @@
-// Code generated by SQLBoiler 4.4.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
-// This file is meant to be re-generated in place and/or deleted at any time.
-
-package models
-
-import "testing"
-
-// This test suite runs each operation test in parallel.
-// Example, if your database has 3 tables, the suite will run:
-// table1, table2 and table3 Delete in parallel
-// table1, table2 and table3 Insert in parallel, and so forth.
-// It does NOT run each operation group in parallel.
-// Separating the tests thusly grants avoidance of Postgres deadlocks.
-func TestParent(t *testing.T) {
-	t.Run("Visits", testVisits)
-}
-
-func TestDelete(t *testing.T) {
-	t.Run("Visits", testVisitsDelete)
-}
-
-func TestQueryDeleteAll(t *testing.T) {
-	t.Run("Visits", testVisitsQueryDeleteAll)
-}
-
-func TestSliceDeleteAll(t *testing.T) {
-	t.Run("Visits", testVisitsSliceDeleteAll)
-}
-
-func TestExists(t *testing.T) {
-	t.Run("Visits", testVisitsExists)
-}
-
-func TestFind(t *testing.T) {
-	t.Run("Visits", testVisitsFind)
-}
-
-func TestBind(t *testing.T) {
-	t.Run("Visits", testVisitsBind)
-}
-
-func TestOne(t *testing.T) {
-	t.Run("Visits", testVisitsOne)
-}
-
-func TestAll(t *testing.T) {
-	t.Run("Visits", testVisitsAll)
-}
-
-func TestCount(t *testing.T) {
-	t.Run("Visits", testVisitsCount)
-}
-
-func TestHooks(t *testing.T) {
-	t.Run("Visits", testVisitsHooks)
-}
-
-func TestInsert(t *testing.T) {
-	t.Run("Visits", testVisitsInsert)
-	t.Run("Visits", testVisitsInsertWhitelist)
-}
-
-// TestToOne tests cannot be run in parallel
-// or deadlocks can occur.
-func TestToOne(t *testing.T) {}
-
-// TestOneToOne tests cannot be run in parallel
-// or deadlocks can occur.
-func TestOneToOne(t *testing.T) {}
-
-// TestToMany tests cannot be run in parallel
-// or deadlocks can occur.
-func TestToMany(t *testing.T) {}
-
-// TestToOneSet tests cannot be run in parallel
-// or deadlocks can occur.
-func TestToOneSet(t *testing.T) {}
-
-// TestToOneRemove tests cannot be run in parallel
-// or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
-
-// TestOneToOneSet tests cannot be run in parallel
-// or deadlocks can occur.
-func TestOneToOneSet(t *testing.T) {}
-
-// TestOneToOneRemove tests cannot be run in parallel
-// or deadlocks can occur.
-func TestOneToOneRemove(t *testing.T) {}
-
-// TestToManyAdd tests cannot be run in parallel
-// or deadlocks can occur.
-func TestToManyAdd(t *testing.T) {}
-
-// TestToManySet tests cannot be run in parallel
-// or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
-
-// TestToManyRemove tests cannot be run in parallel
-// or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
-
-func TestReload(t *testing.T) {
-	t.Run("Visits", testVisitsReload)
-}
-
-func TestReloadAll(t *testing.T) {
-	t.Run("Visits", testVisitsReloadAll)
-}
-
-func TestSelect(t *testing.T) {
-	t.Run("Visits", testVisitsSelect)
-}
-
-func TestUpdate(t *testing.T) {
-	t.Run("Visits", testVisitsUpdate)
-}
-
-func TestSliceUpdateAll(t *testing.T) {
-	t.Run("Visits", testVisitsSliceUpdateAll)
-}
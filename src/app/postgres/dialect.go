@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"github.com/eldad87/go-boilerplate/src/app/sqlstore"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// Dialect of Postgres, the services are shared by sqlstore
+var Dialect = &sqlstore.Dialect{
+	Dialect: drivers.Dialect{
+		LQ:                   '"',
+		RQ:                   '"',
+		UseIndexPlaceholders: true,
+	},
+	Like: "%s ILIKE ?",
+	// Other relays skip the locked batch and produce the next tasks
+	ForUpdate:         "UPDATE SKIP LOCKED",
+	IsUniqueViolation: isUniqueViolation,
+	IsRetryable:       IsRetryable,
+}
@@ -0,0 +1,25 @@
+package postgres
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// Postgres error codes
+const (
+	errUniqueViolation      = "23505"
+	errSerializationFailure = "40001"
+	errDeadlockDetected     = "40P01"
+)
+
+func isUniqueViolation(err error) bool {
+	var pe *pq.Error
+	return errors.As(err, &pe) && pe.Code == errUniqueViolation
+}
+
+// IsRetryable reports whether a transaction that failed on err may succeed if retried, see app.NewTransactor
+func IsRetryable(err error) bool {
+	var pe *pq.Error
+	return errors.As(err, &pe) && (pe.Code == errSerializationFailure || pe.Code == errDeadlockDetected)
+}
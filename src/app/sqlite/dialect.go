@@ -0,0 +1,19 @@
+package sqlite
+
+import (
+	"github.com/eldad87/go-boilerplate/src/app/sqlstore"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// Dialect of SQLite, the services are shared by sqlstore.
+// SQLite has a single writer, the outbox isn't locked and a single relay is expected
+var Dialect = &sqlstore.Dialect{
+	Dialect: drivers.Dialect{
+		LQ:              '"',
+		RQ:              '"',
+		UseLastInsertID: true,
+	},
+	Like:              `%s LIKE ? ESCAPE '\'`,
+	IsUniqueViolation: isUniqueViolation,
+	IsRetryable:       IsRetryable,
+}
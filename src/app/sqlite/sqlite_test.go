@@ -0,0 +1,476 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/app/apptest"
+	"github.com/eldad87/go-boilerplate/src/app/sqlite"
+	"github.com/eldad87/go-boilerplate/src/app/sqlstore"
+	"github.com/eldad87/go-boilerplate/src/pkg/replica"
+	v10validator "github.com/go-playground/validator/v10"
+	_ "github.com/mattn/go-sqlite3"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// newDB opens an in-memory database, migrated up
+func newDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every connection would open a database of its own
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := migrate.Exec(db, "sqlite3", &migrate.FileMigrationSource{Dir: "../../migration/sqlite3"}, migrate.Up); err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func newVisitService(t *testing.T) (app.VisitService, *sql.DB) {
+	db := newDB(t)
+	events := app.NewVisitEvents(10, 10)
+	return sqlstore.NewVisitService(sqlite.Dialect, replica.New(db, nil, 0, nil), newTransactor(db), v10validator.New(), events), db
+}
+
+func newTransactor(db *sql.DB) app.Transactor {
+	return app.NewTransactor(db, sqlite.IsRetryable, 3)
+}
+
+func tenant(id string) context.Context {
+	return app.WithTenant(context.Background(), id)
+}
+
+func count(t *testing.T, db *sql.DB, table string) int {
+	t.Helper()
+
+	var n int
+	if err := db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestVisitService_CreateGetUpdate(t *testing.T) {
+	vs, db := newVisitService(t)
+	c := tenant("acme")
+
+	v, err := vs.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.ID == 0 || v.Version != 1 || v.TenantID != "acme" || v.CreatedAt.IsZero() {
+		t.Fatalf("unexpected created visit %+v", v)
+	}
+
+	got, err := vs.Get(c, &v.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.FirstName != "John" || got.LastName != "Doe" || got.Version != 1 {
+		t.Fatalf("unexpected visit %+v", got)
+	}
+
+	// Only the masked field is written
+	updated, err := vs.Update(c, &app.Visit{ID: v.ID, FirstName: "Jane", LastName: "ignored"}, []string{app.VisitFieldFirstName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.FirstName != "Jane" || updated.LastName != "Doe" || updated.Version != 2 {
+		t.Fatalf("unexpected updated visit %+v", updated)
+	}
+
+	// Create and update were written along with their audit and outbox records
+	if n := count(t, db, "visit_audit"); n != 2 {
+		t.Fatalf("expected 2 audit entries, got %d", n)
+	}
+	if n := count(t, db, "outbox"); n != 2 {
+		t.Fatalf("expected 2 outbox tasks, got %d", n)
+	}
+}
+
+func TestVisitService_Update(t *testing.T) {
+	tests := []struct {
+		name    string
+		visit   app.Visit
+		fields  []string
+		wantErr bool
+		errCode string // Of domain errors, validation errors have none
+	}{
+		{name: "no version", visit: app.Visit{FirstName: "Jane", LastName: "Roe"}},
+		{name: "matching version", visit: app.Visit{FirstName: "Jane", LastName: "Roe", Version: 1}},
+		{name: "stale version", visit: app.Visit{FirstName: "Jane", LastName: "Roe", Version: 7}, wantErr: true, errCode: app.ErrCodeFailedPrecondition},
+		{name: "masked field", visit: app.Visit{LastName: "Roe"}, fields: []string{app.VisitFieldLastName}},
+		{name: "invalid masked field", visit: app.Visit{FirstName: "J"}, fields: []string{app.VisitFieldFirstName}, wantErr: true},
+		{name: "non updatable field", visit: app.Visit{FirstName: "Jane"}, fields: []string{"tenant_id"}, wantErr: true, errCode: app.ErrCodeInvalid},
+		{name: "not found", visit: app.Visit{ID: 1000, FirstName: "Jane", LastName: "Roe"}, wantErr: true, errCode: app.ErrCodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs, _ := newVisitService(t)
+			c := tenant("acme")
+
+			v, err := vs.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			update := tt.visit
+			if update.ID == 0 {
+				update.ID = v.ID
+			}
+			_, err = vs.Update(c, &update, tt.fields)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if app.ErrorCode(err) != tt.errCode {
+				t.Fatalf("expected %s, got %v", tt.errCode, err)
+			}
+		})
+	}
+}
+
+func TestVisitService_Tenants(t *testing.T) {
+	vs, _ := newVisitService(t)
+
+	v, err := vs.Create(tenant("acme"), &app.Visit{FirstName: "John", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := vs.Get(tenant("other"), &v.ID); !app.IsNotFound(err) {
+		t.Fatalf("expected another tenant's visit not to be found, got %v", err)
+	}
+	if err := vs.Delete(tenant("other"), &v.ID); !app.IsNotFound(err) {
+		t.Fatalf("expected another tenant's visit not to be deleted, got %v", err)
+	}
+	if _, err := vs.Get(context.Background(), &v.ID); err != app.ErrTenantRequired {
+		t.Fatalf("expected %v, got %v", app.ErrTenantRequired, err)
+	}
+}
+
+func TestVisitService_DeletePurge(t *testing.T) {
+	vs, db := newVisitService(t)
+	c := tenant("acme")
+
+	v, err := vs.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := vs.Delete(c, &v.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vs.Get(c, &v.ID); !app.IsNotFound(err) {
+		t.Fatalf("expected a deleted visit not to be found, got %v", err)
+	}
+	if err := vs.Delete(c, &v.ID); !app.IsNotFound(err) {
+		t.Fatalf("expected a deleted visit not to be deleted again, got %v", err)
+	}
+
+	// Soft deleted visits can still be purged, erasing their audit values
+	if err := vs.Purge(c, &v.ID); err != nil {
+		t.Fatal(err)
+	}
+	if n := count(t, db, "visits"); n != 0 {
+		t.Fatalf("expected the visit to be purged, %d left", n)
+	}
+
+	audits, err := vs.ListAudit(c, v.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actions := []string{app.VisitAuditCreated, app.VisitAuditDeleted, app.VisitAuditPurged}
+	if len(audits) != len(actions) {
+		t.Fatalf("expected %d audit entries, got %d", len(actions), len(audits))
+	}
+	for i, audit := range audits {
+		if audit.Action != actions[i] {
+			t.Errorf("audit %d: expected %s, got %s", i, actions[i], audit.Action)
+		}
+		if len(audit.OldValues) != 0 || len(audit.NewValues) != 0 {
+			t.Errorf("audit %d: expected values to be erased, got %s %s", i, audit.OldValues, audit.NewValues)
+		}
+	}
+}
+
+func TestVisitService_BatchSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		atomic  bool
+		wantErr bool
+		created int
+	}{
+		{name: "partial", atomic: false, created: 1},
+		{name: "atomic", atomic: true, wantErr: true, created: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs, db := newVisitService(t)
+
+			results, err := vs.BatchSet(tenant("acme"), []*app.Visit{
+				{FirstName: "John", LastName: "Doe"},
+				{ID: 1000, FirstName: "Jane", LastName: "Roe"},
+			}, tt.atomic)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if err == nil && (results[0].Err != nil || !app.IsNotFound(results[1].Err)) {
+				t.Fatalf("unexpected results %v, %v", results[0].Err, results[1].Err)
+			}
+			if n := count(t, db, "visits"); n != tt.created {
+				t.Fatalf("expected %d visits, got %d", tt.created, n)
+			}
+		})
+	}
+}
+
+// newAppTestVisitService creates the VisitService of the tests shared by all implementations, see apptest
+func newAppTestVisitService(t *testing.T) (app.VisitService, func(t *testing.T, id uint, createdAt time.Time)) {
+	vs, db := newVisitService(t)
+	return vs, func(t *testing.T, id uint, createdAt time.Time) {
+		if _, err := db.Exec("UPDATE visits SET created_at = ? WHERE id = ?", createdAt, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestVisitService_List(t *testing.T) {
+	apptest.VisitServiceList(t, newAppTestVisitService)
+}
+
+func TestVisitService_List_InvalidPageToken(t *testing.T) {
+	apptest.VisitServiceListInvalidPageToken(t, newAppTestVisitService)
+}
+
+func TestVisitService_Update_Fields(t *testing.T) {
+	apptest.VisitServiceUpdateFields(t, newAppTestVisitService)
+}
+
+func TestVisitService_Update_Version(t *testing.T) {
+	apptest.VisitServiceUpdateVersion(t, newAppTestVisitService)
+}
+
+func TestVisitService_BatchSet_SameVisit(t *testing.T) {
+	apptest.VisitServiceBatchSetSameVisit(t, newAppTestVisitService)
+}
+
+func TestVisitService_List_Names(t *testing.T) {
+	vs, _ := newVisitService(t)
+	c := tenant("acme")
+
+	names := []string{"Dan", "Bob", "Al_x", "Alex", "Cid"}
+	for _, name := range names {
+		if _, err := vs.Create(c, &app.Visit{FirstName: name, LastName: "Doe"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Another tenant's visits are never listed
+	if _, err := vs.Create(tenant("other"), &app.Visit{FirstName: "Eve", LastName: "Doe"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		filter app.VisitFilter
+		want   []string
+	}{
+		{name: "by id", filter: app.VisitFilter{PageSize: 2}, want: names},
+		{name: "by id descending", filter: app.VisitFilter{PageSize: 2, Descending: true}, want: []string{"Cid", "Alex", "Al_x", "Bob", "Dan"}},
+		{name: "by first name", filter: app.VisitFilter{PageSize: 2, OrderBy: app.VisitOrderByFirstName}, want: []string{"Al_x", "Alex", "Bob", "Cid", "Dan"}},
+		{name: "prefix", filter: app.VisitFilter{FirstNamePrefix: "al"}, want: []string{"Al_x", "Alex"}},
+		{name: "escaped prefix", filter: app.VisitFilter{FirstNamePrefix: "Al_"}, want: []string{"Al_x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			f := tt.filter
+			for pages := 0; ; pages++ {
+				if pages > len(names) {
+					t.Fatal("too many pages")
+				}
+
+				page, err := vs.List(c, &f)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, v := range page.Visits {
+					got = append(got, v.FirstName)
+				}
+				if page.NextPageToken == "" {
+					break
+				}
+				f.PageToken = page.NextPageToken
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	// Tokens are bound to the order they were issued for
+	page, err := vs.List(c, &app.VisitFilter{PageSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vs.List(c, &app.VisitFilter{PageToken: page.NextPageToken, Descending: true}); err != app.ErrInvalidPageToken {
+		t.Fatalf("expected %v, got %v", app.ErrInvalidPageToken, err)
+	}
+	if _, err := vs.List(c, &app.VisitFilter{PageToken: "garbage"}); err != app.ErrInvalidPageToken {
+		t.Fatalf("expected %v, got %v", app.ErrInvalidPageToken, err)
+	}
+}
+
+func TestAccountService(t *testing.T) {
+	db := newDB(t)
+	as := sqlstore.NewAccountService(sqlite.Dialect, db, newTransactor(db))
+	c := tenant("acme")
+
+	a, err := as.Create(c, &app.Account{Email: "john@example.com", PasswordHash: "hash", Roles: []string{"viewer", "editor"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := as.Create(c, &app.Account{Email: "john@example.com", PasswordHash: "hash"}); app.ErrorCode(err) != app.ErrCodeConflict {
+		t.Fatalf("expected a conflict, got %v", err)
+	}
+	// Emails are unique per tenant
+	if _, err := as.Create(tenant("other"), &app.Account{Email: "john@example.com", PasswordHash: "hash"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := as.GetByEmail(c, "john@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != a.ID || fmt.Sprint(got.Roles) != "[viewer editor]" {
+		t.Fatalf("unexpected account %+v", got)
+	}
+
+	updated, err := as.SetPasswordHash(c, a.ID, "new-hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.PasswordHash != "new-hash" || updated.TokenVersion != a.TokenVersion+1 {
+		t.Fatalf("unexpected account %+v", updated)
+	}
+
+	if _, err := as.Get(tenant("other"), a.ID); !app.IsNotFound(err) {
+		t.Fatalf("expected another tenant's account not to be found, got %v", err)
+	}
+}
+
+func TestAPIKeyService(t *testing.T) {
+	db := newDB(t)
+	ks := sqlstore.NewAPIKeyService(sqlite.Dialect, db, newTransactor(db))
+	c := tenant("acme")
+
+	k, err := ks.Create(c, &app.APIKey{Name: "ci", Prefix: "abc", KeyHash: "hash", Scopes: []string{"visits:read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Create(tenant("other"), &app.APIKey{Name: "ci", Prefix: "abc", KeyHash: "hash"}); app.ErrorCode(err) != app.ErrCodeConflict {
+		t.Fatalf("expected prefixes to be unique across tenants, got %v", err)
+	}
+
+	// Looked up by prefix regardless of the tenant, it's yet to be known
+	got, err := ks.GetByPrefix(context.Background(), "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != k.ID || got.TenantID != "acme" || fmt.Sprint(got.Scopes) != "[visits:read]" {
+		t.Fatalf("unexpected key %+v", got)
+	}
+
+	// Rotated keys are found by their new prefix only
+	if _, err := ks.SetSecret(c, k.ID, "def", "new-hash"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.GetByPrefix(context.Background(), "abc"); !app.IsNotFound(err) {
+		t.Fatalf("expected the old prefix not to be found, got %v", err)
+	}
+
+	lastUsed := time.Now().Truncate(time.Second)
+	if err := ks.TouchLastUsed(context.Background(), map[uint]time.Time{k.ID: lastUsed}); err != nil {
+		t.Fatal(err)
+	}
+	got, err = ks.Get(c, k.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.LastUsedAt == nil || !got.LastUsedAt.Equal(lastUsed) {
+		t.Fatalf("expected last used at %v, got %v", lastUsed, got.LastUsedAt)
+	}
+
+	if err := ks.Revoke(c, k.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.GetByPrefix(context.Background(), "def"); !app.IsNotFound(err) {
+		t.Fatalf("expected a revoked key not to be found, got %v", err)
+	}
+	if err := ks.Revoke(c, k.ID); !app.IsNotFound(err) {
+		t.Fatalf("expected a revoked key not to be revoked again, got %v", err)
+	}
+}
+
+func TestVisitService_UnitOfWork(t *testing.T) {
+	db := newDB(t)
+	events := app.NewVisitEvents(10, 10)
+	uow := newTransactor(db)
+	vs := sqlstore.NewVisitService(sqlite.Dialect, replica.New(db, nil, 0, nil), uow, v10validator.New(), events)
+
+	c, cancel := context.WithCancel(tenant("acme"))
+	defer cancel()
+	watch, err := vs.Watch(c, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Writes join the unit of work, a later failure rolls them all back and drops their events
+	errFailed := errors.New("failed")
+	err = uow.WithinTx(tenant("acme"), func(c context.Context) error {
+		if _, err := vs.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"}); err != nil {
+			return err
+		}
+		return errFailed
+	})
+	if err != errFailed {
+		t.Fatalf("expected %v, got %v", errFailed, err)
+	}
+	for _, table := range []string{"visits", "visit_audit", "outbox"} {
+		if n := count(t, db, table); n != 0 {
+			t.Errorf("expected %s to be rolled back, got %d records", table, n)
+		}
+	}
+
+	err = uow.WithinTx(tenant("acme"), func(c context.Context) error {
+		_, err := vs.Create(c, &app.Visit{FirstName: "Jane", LastName: "Roe"})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the committed visit is published
+	select {
+	case event := <-watch:
+		if event.Visit.FirstName != "Jane" {
+			t.Fatalf("expected the committed visit's event, got %+v", event.Visit)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected an event once committed")
+	}
+}
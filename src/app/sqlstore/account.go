@@ -0,0 +1,167 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const accountsTable = "accounts"
+
+// accountRow is a record of the accounts table
+type accountRow struct {
+	ID           uint      `boil:"id"`
+	TenantID     string    `boil:"tenant_id"`
+	Email        string    `boil:"email"`
+	PasswordHash string    `boil:"password_hash"`
+	Roles        string    `boil:"roles"`
+	TokenVersion uint      `boil:"token_version"`
+	CreatedAt    time.Time `boil:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at"`
+}
+
+// NewAccountService creates an AccountService, uow should be a Transactor of db
+func NewAccountService(d *Dialect, db *sql.DB, uow app.Transactor) *accountService {
+	return &accountService{d, db, uow}
+}
+
+// accountService always reads from the primary, logging in right after registering or changing the password must work
+type accountService struct {
+	d   *Dialect
+	db  *sql.DB
+	uow app.Transactor
+}
+
+func (as *accountService) Get(c context.Context, id uint) (*app.Account, error) {
+	row, err := as.one(c, executor(c, as.db), id, as.d.where("accounts.id", "=", id))
+	if err != nil {
+		return nil, err
+	}
+
+	return rowToAccount(row), nil
+}
+
+func (as *accountService) GetByEmail(c context.Context, email string) (*app.Account, error) {
+	row, err := as.one(c, executor(c, as.db), email, as.d.where("accounts.email", "=", email))
+	if err != nil {
+		return nil, err
+	}
+
+	return rowToAccount(row), nil
+}
+
+func (as *accountService) Create(c context.Context, a *app.Account) (*app.Account, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(boil.GetLocation())
+	row := &accountRow{
+		TenantID:     tenantID,
+		Email:        a.Email,
+		PasswordHash: a.PasswordHash,
+		Roles:        strings.Join(a.Roles, ","),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	row.ID, err = as.d.insert(c, executor(c, as.db), accountsTable,
+		[]string{"tenant_id", "email", "password_hash", "roles", "token_version", "created_at", "updated_at"},
+		row.TenantID, row.Email, row.PasswordHash, row.Roles, row.TokenVersion, row.CreatedAt, row.UpdatedAt,
+	)
+	if as.d.IsUniqueViolation(err) {
+		return nil, app.NewConflictError(app.AccountResource, a.Email, "email is already registered")
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rowToAccount(row), nil
+}
+
+func (as *accountService) SetPasswordHash(c context.Context, id uint, passwordHash string) (*app.Account, error) {
+	var account *app.Account
+	err := withTx(c, as.uow, func(tx boil.ContextExecutor) error {
+		row, err := as.one(c, tx, id, as.d.where("accounts.id", "=", id))
+		if err != nil {
+			return err
+		}
+
+		// Compare-and-swap on the token version, concurrent password changes must not both succeed
+		mods, err := as.d.tenantScope(c, accountsTable,
+			as.d.where("accounts.id", "=", id),
+			as.d.where("accounts.token_version", "=", row.TokenVersion),
+		)
+		if err != nil {
+			return err
+		}
+
+		row.PasswordHash = passwordHash
+		row.TokenVersion++
+		row.UpdatedAt = time.Now().In(boil.GetLocation())
+		rowsAff, err := as.d.update(c, tx, accountsTable, map[string]interface{}{
+			"password_hash": row.PasswordHash,
+			"token_version": row.TokenVersion,
+			"updated_at":    row.UpdatedAt,
+		}, mods...)
+		if err != nil {
+			return err
+		} else if rowsAff == 0 {
+			return app.NewAbortedError(app.AccountResource, id, "account was modified concurrently")
+		}
+
+		account = rowToAccount(row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// one reads an account of the tenant of c
+func (as *accountService) one(c context.Context, exec boil.ContextExecutor, name interface{}, mods ...qm.QueryMod) (*accountRow, error) {
+	mods, err := as.d.tenantScope(c, accountsTable, mods...)
+	if err != nil {
+		return nil, err
+	}
+
+	row := &accountRow{}
+	err = as.d.one(c, exec, row, accountsTable, mods...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, app.NewNotFoundError(app.AccountResource, name)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+func rowToAccount(row *accountRow) *app.Account {
+	return &app.Account{
+		ID:           row.ID,
+		Email:        row.Email,
+		PasswordHash: row.PasswordHash,
+		Roles:        splitRoles(row.Roles),
+		TokenVersion: row.TokenVersion,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+		TenantID:     row.TenantID,
+	}
+}
+
+// splitRoles parses a comma separated roles column
+func splitRoles(roles string) []string {
+	if roles == "" {
+		return nil
+	}
+
+	return strings.Split(roles, ",")
+}
@@ -0,0 +1,204 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const apiKeysTable = "api_keys"
+
+// apiKeyRow is a record of the api_keys table
+type apiKeyRow struct {
+	ID         uint      `boil:"id"`
+	TenantID   string    `boil:"tenant_id"`
+	Name       string    `boil:"name"`
+	Prefix     string    `boil:"prefix"`
+	KeyHash    string    `boil:"key_hash"`
+	Scopes     string    `boil:"scopes"`
+	Roles      string    `boil:"roles"`
+	ExpiresAt  null.Time `boil:"expires_at"`
+	LastUsedAt null.Time `boil:"last_used_at"`
+	CreatedAt  time.Time `boil:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at"`
+	DeletedAt  null.Time `boil:"deleted_at"`
+}
+
+// NewAPIKeyService creates an APIKeyService, uow should be a Transactor of db
+func NewAPIKeyService(d *Dialect, db *sql.DB, uow app.Transactor) *apiKeyService {
+	return &apiKeyService{d, db, uow}
+}
+
+// apiKeyService always reads from the primary, revoked and rotated keys must stop working right away
+type apiKeyService struct {
+	d   *Dialect
+	db  *sql.DB
+	uow app.Transactor
+}
+
+func (ks *apiKeyService) Get(c context.Context, id uint) (*app.APIKey, error) {
+	mods, err := ks.tenantAPIKeys(c, ks.d.where("api_keys.id", "=", id))
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := ks.one(c, executor(c, ks.db), id, mods...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowToAPIKey(row), nil
+}
+
+func (ks *apiKeyService) GetByPrefix(c context.Context, prefix string) (*app.APIKey, error) {
+	// Not scoped, the key's tenant is yet to be known
+	row, err := ks.one(c, executor(c, ks.db), prefix,
+		qm.Where(ks.d.quote("api_keys.deleted_at")+" IS NULL"),
+		ks.d.where("api_keys.prefix", "=", prefix),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowToAPIKey(row), nil
+}
+
+func (ks *apiKeyService) Create(c context.Context, k *app.APIKey) (*app.APIKey, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(boil.GetLocation())
+	row := &apiKeyRow{
+		TenantID:  tenantID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		KeyHash:   k.KeyHash,
+		Scopes:    strings.Join(k.Scopes, " "),
+		Roles:     strings.Join(k.Roles, ","),
+		ExpiresAt: null.TimeFromPtr(k.ExpiresAt),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	row.ID, err = ks.d.insert(c, executor(c, ks.db), apiKeysTable,
+		[]string{"tenant_id", "name", "prefix", "key_hash", "scopes", "roles", "expires_at", "created_at", "updated_at"},
+		row.TenantID, row.Name, row.Prefix, row.KeyHash, row.Scopes, row.Roles, row.ExpiresAt, row.CreatedAt, row.UpdatedAt,
+	)
+	if ks.d.IsUniqueViolation(err) {
+		return nil, app.NewConflictError(app.APIKeyResource, k.Prefix, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rowToAPIKey(row), nil
+}
+
+func (ks *apiKeyService) SetSecret(c context.Context, id uint, prefix string, keyHash string) (*app.APIKey, error) {
+	mods, err := ks.tenantAPIKeys(c, ks.d.where("api_keys.id", "=", id))
+	if err != nil {
+		return nil, err
+	}
+
+	var k *app.APIKey
+	err = withTx(c, ks.uow, func(tx boil.ContextExecutor) error {
+		row, err := ks.one(c, tx, id, mods...)
+		if err != nil {
+			return err
+		}
+
+		row.Prefix = prefix
+		row.KeyHash = keyHash
+		row.UpdatedAt = time.Now().In(boil.GetLocation())
+		_, err = ks.d.update(c, tx, apiKeysTable, map[string]interface{}{
+			"prefix":     row.Prefix,
+			"key_hash":   row.KeyHash,
+			"updated_at": row.UpdatedAt,
+		}, ks.d.where("api_keys.id", "=", row.ID))
+		if ks.d.IsUniqueViolation(err) {
+			return app.NewConflictError(app.APIKeyResource, prefix, err.Error())
+		} else if err != nil {
+			return err
+		}
+
+		k = rowToAPIKey(row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+func (ks *apiKeyService) Revoke(c context.Context, id uint) error {
+	mods, err := ks.tenantAPIKeys(c, ks.d.where("api_keys.id", "=", id))
+	if err != nil {
+		return err
+	}
+
+	// Soft delete, only keys that aren't already revoked are affected
+	rowsAff, err := ks.d.update(c, executor(c, ks.db), apiKeysTable, map[string]interface{}{"deleted_at": time.Now().In(boil.GetLocation())}, mods...)
+	if err != nil {
+		return err
+	} else if rowsAff == 0 {
+		return app.NewNotFoundError(app.APIKeyResource, id)
+	}
+
+	return nil
+}
+
+func (ks *apiKeyService) TouchLastUsed(c context.Context, lastUsed map[uint]time.Time) error {
+	return withTx(c, ks.uow, func(tx boil.ContextExecutor) error {
+		for id, t := range lastUsed {
+			_, err := ks.d.update(c, tx, apiKeysTable, map[string]interface{}{
+				"last_used_at": null.TimeFrom(t.In(boil.GetLocation())),
+			}, ks.d.where("api_keys.id", "=", id))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (ks *apiKeyService) one(c context.Context, exec boil.ContextExecutor, name interface{}, mods ...qm.QueryMod) (*apiKeyRow, error) {
+	row := &apiKeyRow{}
+	err := ks.d.one(c, exec, row, apiKeysTable, mods...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, app.NewNotFoundError(app.APIKeyResource, name)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// tenantAPIKeys scopes an API keys query to the tenant of c, skipping revoked keys
+func (ks *apiKeyService) tenantAPIKeys(c context.Context, mods ...qm.QueryMod) ([]qm.QueryMod, error) {
+	return ks.d.tenantScope(c, apiKeysTable, append([]qm.QueryMod{qm.Where(ks.d.quote("api_keys.deleted_at") + " IS NULL")}, mods...)...)
+}
+
+func rowToAPIKey(row *apiKeyRow) *app.APIKey {
+	return &app.APIKey{
+		ID:         row.ID,
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		KeyHash:    row.KeyHash,
+		Scopes:     strings.Fields(row.Scopes),
+		Roles:      splitRoles(row.Roles),
+		ExpiresAt:  row.ExpiresAt.Ptr(),
+		LastUsedAt: row.LastUsedAt.Ptr(),
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+		TenantID:   row.TenantID,
+	}
+}
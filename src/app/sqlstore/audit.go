@@ -0,0 +1,101 @@
+package sqlstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const visitAuditTable = "visit_audit"
+
+// visitAuditRow is a record of the visit_audit table
+type visitAuditRow struct {
+	ID        uint64    `boil:"id"`
+	VisitID   uint      `boil:"visit_id"`
+	Action    string    `boil:"action"`
+	Principal string    `boil:"principal"`
+	RequestID string    `boil:"request_id"`
+	TraceID   string    `boil:"trace_id"`
+	OldValues null.JSON `boil:"old_values"`
+	NewValues null.JSON `boil:"new_values"`
+	CreatedAt time.Time `boil:"created_at"`
+	TenantID  string    `boil:"tenant_id"`
+}
+
+// recordAudit writes the change of a visit to the audit log, exec should be the transaction of the change.
+// The actor is taken from c, see app.WithActor
+func (d *Dialect) recordAudit(c context.Context, exec boil.ContextExecutor, action string, visitID uint, old *app.Visit, new *app.Visit) error {
+	oldValues, newValues, err := app.VisitDiff(old, new)
+	if err != nil {
+		return err
+	}
+
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	actor := app.ActorFromContext(c)
+	_, err = d.insert(c, exec, visitAuditTable,
+		[]string{"tenant_id", "visit_id", "action", "principal", "request_id", "trace_id", "old_values", "new_values", "created_at"},
+		tenantID, visitID, action, actor.Principal, actor.RequestID, actor.TraceID, nullJSON(oldValues), nullJSON(newValues), time.Now().In(boil.GetLocation()),
+	)
+	return err
+}
+
+// eraseAudit removes the values of a visit's audit entries, its personal data included. Who changed it and when is kept
+func (d *Dialect) eraseAudit(c context.Context, exec boil.ContextExecutor, visitID uint) error {
+	mods, err := d.tenantScope(c, visitAuditTable, d.where("visit_audit.visit_id", "=", visitID))
+	if err != nil {
+		return err
+	}
+
+	_, err = d.update(c, exec, visitAuditTable, map[string]interface{}{
+		"old_values": nil,
+		"new_values": nil,
+	}, mods...)
+	return err
+}
+
+func (vs *visitService) ListAudit(c context.Context, visitID uint) ([]*app.VisitAudit, error) {
+	mods, err := vs.d.tenantScope(c, visitAuditTable,
+		vs.d.where("visit_audit.visit_id", "=", visitID),
+		qm.OrderBy(vs.d.quote("visit_audit.id")),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []*visitAuditRow
+	if err := vs.d.all(c, executor(c, vs.db.Reader(c)), &rows, visitAuditTable, mods...); err != nil {
+		return nil, err
+	}
+
+	audits := make([]*app.VisitAudit, len(rows))
+	for i, row := range rows {
+		audits[i] = &app.VisitAudit{
+			ID:        row.ID,
+			VisitID:   visitID,
+			Action:    row.Action,
+			Actor:     app.Actor{Principal: row.Principal, RequestID: row.RequestID, TraceID: row.TraceID},
+			TenantID:  row.TenantID,
+			OldValues: row.OldValues.JSON,
+			NewValues: row.NewValues.JSON,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+
+	return audits, nil
+}
+
+func nullJSON(values []byte) null.JSON {
+	if values == nil {
+		return null.JSON{}
+	}
+
+	return null.JSONFrom(values)
+}
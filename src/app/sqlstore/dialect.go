@@ -0,0 +1,117 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/strmangle"
+)
+
+// Dialect holds what differs between the SQL databases, the services and their queries are shared.
+// See the Dialect of the mysql, postgres and sqlite packages
+type Dialect struct {
+	// Quoting and placeholders of sqlboiler's query builder. With UseLastInsertID unset, IDs are read using RETURNING
+	drivers.Dialect
+
+	// Like is the "starts with" condition of a column (%s), its pattern is built by likePrefix
+	Like string
+	// ForUpdate locks the outbox tasks a relay produces, e.g "UPDATE SKIP LOCKED".
+	// Empty if the database has a single writer, tasks are then relayed outside of a transaction and a single relay is expected
+	ForUpdate string
+
+	IsUniqueViolation func(err error) bool
+	// IsRetryable reports whether a transaction that failed on err may succeed if retried, see app.NewTransactor
+	IsRetryable func(err error) bool
+}
+
+// quote quotes an identifier, e.g visits.id
+func (d *Dialect) quote(ident string) string {
+	return strmangle.IdentQuote(d.LQ, d.RQ, ident)
+}
+
+// query builds a query of table
+func (d *Dialect) query(table string, mods ...qm.QueryMod) *queries.Query {
+	q := &queries.Query{}
+	queries.SetDialect(q, &d.Dialect)
+	queries.SetFrom(q, d.quote(table))
+	qm.Apply(q, mods...)
+
+	return q
+}
+
+// where is a condition on a column, e.g where("visits.id", "=", id)
+func (d *Dialect) where(col string, op string, arg interface{}) qm.QueryMod {
+	return qm.Where(d.quote(col)+" "+op+" ?", arg)
+}
+
+// one reads the first record of table into obj, sql.ErrNoRows if there is none
+func (d *Dialect) one(c context.Context, exec boil.ContextExecutor, obj interface{}, table string, mods ...qm.QueryMod) error {
+	return d.query(table, append(mods, qm.Limit(1))...).Bind(c, exec, obj)
+}
+
+// all reads the records of table into obj, a pointer to a slice
+func (d *Dialect) all(c context.Context, exec boil.ContextExecutor, obj interface{}, table string, mods ...qm.QueryMod) error {
+	return d.query(table, mods...).Bind(c, exec, obj)
+}
+
+// insert writes a record to table, returns its ID
+func (d *Dialect) insert(c context.Context, exec boil.ContextExecutor, table string, cols []string, values ...interface{}) (uint, error) {
+	query := "INSERT INTO " + d.quote(table) +
+		" (" + strings.Join(strmangle.IdentQuoteSlice(d.LQ, d.RQ, cols), ", ") + ")" +
+		" VALUES (" + strmangle.Placeholders(d.UseIndexPlaceholders, len(cols), 1, 1) + ")"
+
+	if d.UseLastInsertID {
+		res, err := exec.ExecContext(c, query, values...)
+		if err != nil {
+			return 0, err
+		}
+
+		id, err := res.LastInsertId()
+		return uint(id), err
+	}
+
+	var id uint
+	err := exec.QueryRowContext(c, query+" RETURNING "+d.quote("id"), values...).Scan(&id)
+	return id, err
+}
+
+// update writes cols to the records of table, returns the number of records affected
+func (d *Dialect) update(c context.Context, exec boil.ContextExecutor, table string, cols map[string]interface{}, mods ...qm.QueryMod) (int64, error) {
+	q := d.query(table, mods...)
+	queries.SetUpdate(q, cols)
+
+	return rowsAffected(q.ExecContext(c, exec))
+}
+
+// delete removes the records of table, returns the number of records affected
+func (d *Dialect) delete(c context.Context, exec boil.ContextExecutor, table string, mods ...qm.QueryMod) (int64, error) {
+	q := d.query(table, mods...)
+	queries.SetDelete(q)
+
+	return rowsAffected(q.ExecContext(c, exec))
+}
+
+// tenantScope scopes a query of table to the tenant of c. Every query of the tenants' tables is built using it,
+// so a tenant can't reach the records of another
+func (d *Dialect) tenantScope(c context.Context, table string, mods ...qm.QueryMod) ([]qm.QueryMod, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]qm.QueryMod{d.where(table+".tenant_id", "=", tenantID)}, mods...), nil
+}
+
+func rowsAffected(res sql.Result, err error) (int64, error) {
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,175 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/pkg/task/producer"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+)
+
+const outboxTable = "outbox"
+
+// outboxRow is a record of the outbox table
+type outboxRow struct {
+	ID          uint64      `boil:"id"`
+	TaskName    string      `boil:"task_name"`
+	Payload     []byte      `boil:"payload"`
+	Attempts    uint        `boil:"attempts"`
+	LastError   null.String `boil:"last_error"`
+	AvailableAt time.Time   `boil:"available_at"`
+	CreatedAt   time.Time   `boil:"created_at"`
+}
+
+// enqueueTask writes a task to the outbox, exec should be the transaction of the write it describes
+func (d *Dialect) enqueueTask(c context.Context, exec boil.ContextExecutor, name string, arg interface{}) error {
+	payload, err := json.Marshal(arg)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().In(boil.GetLocation())
+	_, err = d.insert(c, exec, outboxTable, []string{"task_name", "payload", "available_at", "created_at"}, name, payload, now, now)
+	return err
+}
+
+type OutboxRelayConfig struct {
+	Interval      time.Duration // Time between polls, when the outbox is drained
+	BatchSize     int           // Tasks produced per transaction
+	RetryDelay    time.Duration // Delay of the first retry, doubled on every attempt
+	MaxRetryDelay time.Duration
+	Timeout       time.Duration // Deadline of a batch, 0 for none
+}
+
+// NewOutboxRelay creates a relay that produces the outbox tasks, uow should be a Transactor of db.
+// A task is removed only after it was produced, so it may be produced more than once (e.g on crash), never lost.
+func NewOutboxRelay(d *Dialect, db *sql.DB, uow app.Transactor, p producer.Producer, logger *zap.Logger, conf OutboxRelayConfig) *OutboxRelay {
+	return &OutboxRelay{d: d, db: db, uow: uow, p: p, logger: logger, conf: conf}
+}
+
+type OutboxRelay struct {
+	d      *Dialect
+	db     *sql.DB
+	uow    app.Transactor
+	p      producer.Producer
+	logger *zap.Logger
+	conf   OutboxRelayConfig
+}
+
+// Run relays tasks until c is done
+func (r *OutboxRelay) Run(c context.Context) {
+	ticker := time.NewTicker(r.conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		// Keep going while there are full batches
+		for {
+			n, err := r.relay(c)
+			if err != nil {
+				r.logger.Error("Failed to relay outbox", zap.Error(err))
+			}
+			if err != nil || n < r.conf.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-c.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay produces a single batch of due tasks, returns the number of tasks read.
+// The batch is locked by Dialect.ForUpdate, unless the database has a single writer
+func (r *OutboxRelay) relay(c context.Context) (int, error) {
+	if r.conf.Timeout > 0 {
+		var cancel context.CancelFunc
+		c, cancel = context.WithTimeout(c, r.conf.Timeout)
+		defer cancel()
+	}
+
+	if r.d.ForUpdate == "" {
+		return r.relayBatch(c, r.db)
+	}
+
+	var n int
+	err := withTx(c, r.uow, func(tx boil.ContextExecutor) error {
+		var err error
+		n, err = r.relayBatch(c, tx)
+		return err
+	})
+
+	return n, err
+}
+
+func (r *OutboxRelay) relayBatch(c context.Context, exec boil.ContextExecutor) (int, error) {
+	mods := []qm.QueryMod{
+		r.d.where("outbox.available_at", "<=", time.Now().In(boil.GetLocation())),
+		qm.OrderBy(r.d.quote("outbox.id")),
+		qm.Limit(r.conf.BatchSize),
+	}
+	if r.d.ForUpdate != "" {
+		mods = append(mods, qm.For(r.d.ForUpdate))
+	}
+
+	var rows []*outboxRow
+	if err := r.d.all(c, exec, &rows, outboxTable, mods...); err != nil {
+		return 0, err
+	}
+
+	for _, row := range rows {
+		if produceErr := r.produce(c, row); produceErr != nil {
+			if err := r.retryLater(c, exec, row, produceErr); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if _, err := r.d.delete(c, exec, outboxTable, r.d.where("outbox.id", "=", row.ID)); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(rows), nil
+}
+
+func (r *OutboxRelay) produce(c context.Context, row *outboxRow) error {
+	req, err := r.p.NewRequest(row.TaskName, nil, string(row.Payload))
+	if err != nil {
+		return err
+	}
+
+	_, err = r.p.ProduceWithContext(c, req, nil)
+	return err
+}
+
+// retryLater postpones a task that failed to produce, using an exponential backoff
+func (r *OutboxRelay) retryLater(c context.Context, exec boil.ContextExecutor, row *outboxRow, produceErr error) error {
+	r.logger.Warn("Failed to produce outbox task, will retry",
+		zap.Uint64("id", row.ID),
+		zap.String("task", row.TaskName),
+		zap.Uint("attempts", row.Attempts+1),
+		zap.Error(produceErr),
+	)
+
+	delay := r.conf.RetryDelay << row.Attempts
+	if delay <= 0 || delay > r.conf.MaxRetryDelay {
+		delay = r.conf.MaxRetryDelay
+	}
+
+	_, err := r.d.update(c, exec, outboxTable, map[string]interface{}{
+		"attempts":     row.Attempts + 1,
+		"last_error":   null.StringFrom(produceErr.Error()),
+		"available_at": time.Now().Add(delay).In(boil.GetLocation()),
+	}, r.d.where("outbox.id", "=", row.ID))
+
+	return err
+}
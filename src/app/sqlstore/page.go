@@ -0,0 +1,40 @@
+package sqlstore
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// afterVisit returns a keyset condition that skips everything up to (and including) after, the last visit of the previous page
+func (d *Dialect) afterVisit(orderBy string, descending bool, after *app.Visit) (qm.QueryMod, error) {
+	op := ">"
+	if descending {
+		op = "<"
+	}
+
+	var value interface{}
+	switch orderBy {
+	case app.VisitOrderByID:
+		return d.where("visits.id", op, after.ID), nil
+	case app.VisitOrderByFirstName:
+		value = after.FirstName
+	case app.VisitOrderByLastName:
+		value = after.LastName
+	case app.VisitOrderByCreatedAt:
+		value = after.CreatedAt
+	default:
+		return nil, errors.New("unsupported order")
+	}
+
+	col := d.quote("visits." + orderBy)
+	id := d.quote("visits.id")
+	return qm.Where("("+col+" "+op+" ? OR ("+col+" = ? AND "+id+" "+op+" ?))", value, value, after.ID), nil
+}
+
+// likePrefix escapes LIKE wildcards and builds a "starts with" pattern, see Dialect.Like
+func likePrefix(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s) + "%"
+}
@@ -0,0 +1,27 @@
+package sqlstore
+
+import (
+	"context"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// withTx runs fn in a unit of work of uow, committed only if fn succeeds and retried on deadlocks (see Dialect.IsRetryable).
+// If c already belongs to a unit of work, fn joins its transaction instead.
+// fn may run more than once, it must not leave anything behind but its writes
+func withTx(c context.Context, uow app.Transactor, fn func(tx boil.ContextExecutor) error) error {
+	return uow.WithinTx(c, func(c context.Context) error {
+		tx, _ := app.TxFromContext(c)
+		return fn(tx)
+	})
+}
+
+// executor returns the transaction of the unit of work c belongs to, db if there is none
+func executor(c context.Context, db boil.ContextExecutor) boil.ContextExecutor {
+	if tx, ok := app.TxFromContext(c); ok {
+		return tx
+	}
+
+	return db
+}
@@ -0,0 +1,531 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/pkg/replica"
+	"github.com/eldad87/go-boilerplate/src/pkg/validator"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const visitsTable = "visits"
+
+// visitRow is a record of the visits table
+type visitRow struct {
+	ID        uint        `boil:"id"`
+	FirstName null.String `boil:"first_name"`
+	LastName  null.String `boil:"last_name"`
+	CreatedAt time.Time   `boil:"created_at"`
+	UpdatedAt time.Time   `boil:"updated_at"`
+	DeletedAt null.Time   `boil:"deleted_at"`
+	Version   uint        `boil:"version"`
+	TenantID  string      `boil:"tenant_id"`
+}
+
+// NewVisitService creates a VisitService, writes go to the primary and reads are spread across replicas.
+// uow should be a Transactor of the primary
+func NewVisitService(d *Dialect, db *replica.Router, uow app.Transactor, sv validator.StructValidator, events *app.VisitEvents) *visitService {
+	return &visitService{d, db, uow, sv, events}
+}
+
+type visitService struct {
+	d      *Dialect
+	db     *replica.Router
+	uow    app.Transactor
+	sv     validator.StructValidator
+	events *app.VisitEvents
+}
+
+// visitUpdatableFields are the app.Visit fields that Update may write
+var visitUpdatableFields = map[string]bool{
+	app.VisitFieldFirstName: true,
+	app.VisitFieldLastName:  true,
+}
+
+func (vs *visitService) Get(c context.Context, id *uint) (*app.Visit, error) {
+	row, err := vs.one(c, executor(c, vs.db.Reader(c)), *id)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowToVisit(row), nil
+}
+
+func (vs *visitService) Create(c context.Context, v *app.Visit) (*app.Visit, error) {
+	err := vs.sv.StructCtx(c, v)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *app.Visit
+	err = withTx(c, vs.uow, func(tx boil.ContextExecutor) error {
+		if created, err = vs.create(c, tx, v); err != nil {
+			return err
+		}
+		if err := vs.d.recordAudit(c, tx, app.VisitAuditCreated, created.ID, nil, created); err != nil {
+			return err
+		}
+		return vs.d.enqueueTask(c, tx, app.VisitTaskCreated, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	app.AfterCommit(c, func() { vs.events.Publish(app.VisitEventCreated, created) })
+	return created, nil
+}
+
+func (vs *visitService) Update(c context.Context, v *app.Visit, fields []string) (*app.Visit, error) {
+	fields, err := vs.validateUpdate(c, v, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := vs.one(c, executor(c, vs.db.Primary()), v.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *app.Visit
+	err = withTx(c, vs.uow, func(tx boil.ContextExecutor) error {
+		written, err := vs.update(c, tx, row, v, fields)
+		if err != nil {
+			return err
+		}
+		updated = rowToVisit(written)
+		if err := vs.d.recordAudit(c, tx, app.VisitAuditUpdated, updated.ID, rowToVisit(row), updated); err != nil {
+			return err
+		}
+		return vs.d.enqueueTask(c, tx, app.VisitTaskUpdated, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	app.AfterCommit(c, func() { vs.events.Publish(app.VisitEventUpdated, updated) })
+	return updated, nil
+}
+
+func (vs *visitService) BatchGet(c context.Context, ids []uint) ([]*app.Visit, error) {
+	if len(ids) > app.VisitBatchMaxSize {
+		return nil, app.NewInvalidError(app.VisitResource, "ids", "too many visits in a single batch")
+	}
+
+	rows, err := vs.findAll(c, executor(c, vs.db.Reader(c)), ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the requested order, skip missing visits
+	visits := make([]*app.Visit, 0, len(ids))
+	for _, id := range ids {
+		if row, ok := rows[id]; ok {
+			visits = append(visits, rowToVisit(row))
+		}
+	}
+
+	return visits, nil
+}
+
+func (vs *visitService) BatchSet(c context.Context, visits []*app.Visit, atomic bool) ([]*app.VisitBatchResult, error) {
+	if len(visits) > app.VisitBatchMaxSize {
+		return nil, app.NewInvalidError(app.VisitResource, "visits", "too many visits in a single batch")
+	}
+
+	// Read all updated visits at once
+	var ids []uint
+	for _, v := range visits {
+		if v.ID != 0 {
+			ids = append(ids, v.ID)
+		}
+	}
+
+	results := make([]*app.VisitBatchResult, len(visits))
+	err := withTx(c, vs.uow, func(tx boil.ContextExecutor) error {
+		rows, err := vs.findAll(c, tx, ids)
+		if err != nil {
+			return err
+		}
+
+		for i, v := range visits {
+			result := &app.VisitBatchResult{}
+			task, action := app.VisitTaskCreated, app.VisitAuditCreated
+			var old *app.Visit
+			if v.ID == 0 {
+				if result.Err = vs.sv.StructCtx(c, v); result.Err == nil {
+					result.Visit, result.Err = vs.create(c, tx, v)
+				}
+			} else if row, ok := rows[v.ID]; !ok {
+				result.Err = app.NewNotFoundError(app.VisitResource, v.ID)
+			} else {
+				task, action = app.VisitTaskUpdated, app.VisitAuditUpdated
+				old = rowToVisit(row)
+				var fields []string
+				var written *visitRow
+				if fields, result.Err = vs.validateUpdate(c, v, nil); result.Err == nil {
+					if written, result.Err = vs.update(c, tx, row, v, fields); result.Err == nil {
+						// The same visit may be updated again later in the batch, on top of this write
+						rows[v.ID] = written
+						result.Visit = rowToVisit(written)
+					}
+				}
+			}
+
+			// In atomic mode, a single failure discards the whole batch
+			if result.Err != nil && atomic {
+				return result.Err
+			}
+
+			// Failing to write the audit or the task isn't the visit's fault, discard the whole batch
+			if result.Err == nil {
+				if err := vs.d.recordAudit(c, tx, action, result.Visit.ID, old, result.Visit); err != nil {
+					return err
+				}
+				if err := vs.d.enqueueTask(c, tx, task, result.Visit); err != nil {
+					return err
+				}
+			}
+			results[i] = result
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	app.AfterCommit(c, func() {
+		for i, result := range results {
+			if result.Err != nil {
+				continue
+			}
+
+			if visits[i].ID == 0 {
+				vs.events.Publish(app.VisitEventCreated, result.Visit)
+			} else {
+				vs.events.Publish(app.VisitEventUpdated, result.Visit)
+			}
+		}
+	})
+
+	return results, nil
+}
+
+// one reads a visit of the tenant of c
+func (vs *visitService) one(c context.Context, exec boil.ContextExecutor, id uint) (*visitRow, error) {
+	mods, err := vs.tenantVisits(c, vs.d.where("visits.id", "=", id))
+	if err != nil {
+		return nil, err
+	}
+
+	row := &visitRow{}
+	err = vs.d.one(c, exec, row, visitsTable, mods...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, app.NewNotFoundError(app.VisitResource, id)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// findAll reads the given visits using a single query, mapped by ID
+func (vs *visitService) findAll(c context.Context, exec boil.ContextExecutor, ids []uint) (map[uint]*visitRow, error) {
+	// Scoped before anything else, a batch without a tenant fails even if it has nothing to read
+	mods, err := vs.tenantVisits(c)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[uint]*visitRow, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	var rows []*visitRow
+	if err := vs.d.all(c, exec, &rows, visitsTable, append(mods, qm.WhereIn(vs.d.quote("visits.id")+" IN ?", args...))...); err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		res[row.ID] = row
+	}
+
+	return res, nil
+}
+
+// create inserts an already validated visit
+func (vs *visitService) create(c context.Context, exec boil.ContextExecutor, v *app.Visit) (*app.Visit, error) {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(boil.GetLocation())
+	row := &visitRow{
+		FirstName: null.StringFrom(v.FirstName),
+		LastName:  null.StringFrom(v.LastName),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Version:   1,
+		TenantID:  tenantID,
+	}
+
+	row.ID, err = vs.d.insert(c, exec, visitsTable,
+		[]string{"first_name", "last_name", "created_at", "updated_at", "version", "tenant_id"},
+		row.FirstName, row.LastName, row.CreatedAt, row.UpdatedAt, row.Version, row.TenantID,
+	)
+	if vs.d.IsUniqueViolation(err) {
+		return nil, app.NewConflictError(app.VisitResource, v.ID, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rowToVisit(row), nil
+}
+
+// validateUpdate validates the updated fields, all updatable fields are returned if none are given
+func (vs *visitService) validateUpdate(c context.Context, v *app.Visit, fields []string) ([]string, error) {
+	if len(fields) == 0 {
+		fields = []string{app.VisitFieldFirstName, app.VisitFieldLastName}
+	}
+
+	for _, field := range fields {
+		if !visitUpdatableFields[field] {
+			return nil, app.NewInvalidError(app.VisitResource, field, "field "+field+" can't be updated")
+		}
+	}
+
+	return fields, vs.sv.StructPartialCtx(c, v, fields...)
+}
+
+// update writes the given fields of v over row, as long as row wasn't modified since it was read, and returns the written row.
+// row is left as is, the unit of work may be retried
+func (vs *visitService) update(c context.Context, exec boil.ContextExecutor, row *visitRow, v *app.Visit, fields []string) (*visitRow, error) {
+	if v.Version != 0 && v.Version != row.Version {
+		return nil, app.NewVersionMismatchError(app.VisitResource, v.ID)
+	}
+
+	// Write only the requested fields
+	updated := *row
+	updated.UpdatedAt = time.Now().In(boil.GetLocation())
+	updated.Version++
+	cols := map[string]interface{}{
+		"updated_at": updated.UpdatedAt,
+		"version":    updated.Version,
+	}
+	for _, field := range fields {
+		switch field {
+		case app.VisitFieldFirstName:
+			updated.FirstName = null.StringFrom(v.FirstName)
+			cols["first_name"] = updated.FirstName
+		case app.VisitFieldLastName:
+			updated.LastName = null.StringFrom(v.LastName)
+			cols["last_name"] = updated.LastName
+		}
+	}
+
+	// Compare-and-swap on the version we've read, someone else may have updated it in between
+	mods, err := vs.tenantVisits(c,
+		vs.d.where("visits.id", "=", row.ID),
+		vs.d.where("visits.version", "=", row.Version),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAff, err := vs.d.update(c, exec, visitsTable, cols, mods...)
+	if vs.d.IsUniqueViolation(err) {
+		return nil, app.NewConflictError(app.VisitResource, v.ID, err.Error())
+	} else if err != nil {
+		return nil, err
+	} else if rowsAff == 0 {
+		return nil, app.NewAbortedError(app.VisitResource, v.ID, "visit was modified concurrently")
+	}
+
+	return &updated, nil
+}
+
+func (vs *visitService) Delete(c context.Context, id *uint) error {
+	var deleted *app.Visit
+	err := withTx(c, vs.uow, func(tx boil.ContextExecutor) error {
+		// Read the deleted values, for the audit log
+		row, err := vs.one(c, tx, *id)
+		if err != nil {
+			return err
+		}
+
+		// Soft delete, only records that aren't already deleted are affected
+		mods, err := vs.tenantVisits(c, vs.d.where("visits.id", "=", *id))
+		if err != nil {
+			return err
+		}
+		rowsAff, err := vs.d.update(c, tx, visitsTable, map[string]interface{}{"deleted_at": time.Now().In(boil.GetLocation())}, mods...)
+		if err != nil {
+			return err
+		} else if rowsAff == 0 {
+			return app.NewNotFoundError(app.VisitResource, *id)
+		}
+		if err := vs.d.recordAudit(c, tx, app.VisitAuditDeleted, *id, rowToVisit(row), nil); err != nil {
+			return err
+		}
+		deleted = &app.Visit{ID: *id, TenantID: row.TenantID}
+		return vs.d.enqueueTask(c, tx, app.VisitTaskDeleted, deleted)
+	})
+	if err != nil {
+		return err
+	}
+
+	app.AfterCommit(c, func() { vs.events.Publish(app.VisitEventDeleted, deleted) })
+	return nil
+}
+
+func (vs *visitService) Purge(c context.Context, id *uint) error {
+	tenantID, err := app.TenantFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	deleted := &app.Visit{ID: *id, TenantID: tenantID}
+	err = withTx(c, vs.uow, func(tx boil.ContextExecutor) error {
+		// Hard delete, regardless of the record's deleted_at
+		mods, err := vs.d.tenantScope(c, visitsTable, vs.d.where("visits.id", "=", *id))
+		if err != nil {
+			return err
+		}
+		rowsAff, err := vs.d.delete(c, tx, visitsTable, mods...)
+		if err != nil {
+			return err
+		} else if rowsAff == 0 {
+			return app.NewNotFoundError(app.VisitResource, *id)
+		}
+
+		// Keep the history, without the visit's personal data
+		if err := vs.d.eraseAudit(c, tx, *id); err != nil {
+			return err
+		}
+		if err := vs.d.recordAudit(c, tx, app.VisitAuditPurged, *id, nil, nil); err != nil {
+			return err
+		}
+		return vs.d.enqueueTask(c, tx, app.VisitTaskDeleted, deleted)
+	})
+	if err != nil {
+		return err
+	}
+
+	app.AfterCommit(c, func() { vs.events.Publish(app.VisitEventDeleted, deleted) })
+	return nil
+}
+
+func (vs *visitService) Watch(c context.Context, lastEventID uint64) (<-chan *app.VisitEvent, error) {
+	return vs.events.Watch(c, lastEventID)
+}
+
+func (vs *visitService) List(c context.Context, f *app.VisitFilter) (*app.VisitPage, error) {
+	err := vs.sv.StructCtx(c, f)
+	if err != nil {
+		return nil, err
+	}
+
+	orderBy := f.OrderBy
+	if orderBy == "" {
+		orderBy = app.VisitOrderByID
+	}
+
+	pageSize := int(f.PageSize)
+	if pageSize == 0 {
+		pageSize = app.VisitListDefaultPageSize
+	}
+
+	mods, err := vs.tenantVisits(c)
+	if err != nil {
+		return nil, err
+	}
+	if f.FirstNamePrefix != "" {
+		mods = append(mods, qm.Where(fmt.Sprintf(vs.d.Like, vs.d.quote("visits.first_name")), likePrefix(f.FirstNamePrefix)))
+	}
+	if f.LastNamePrefix != "" {
+		mods = append(mods, qm.Where(fmt.Sprintf(vs.d.Like, vs.d.quote("visits.last_name")), likePrefix(f.LastNamePrefix)))
+	}
+	if f.CreatedAfter != nil {
+		mods = append(mods, vs.d.where("visits.created_at", ">=", *f.CreatedAfter))
+	}
+	if f.CreatedBefore != nil {
+		mods = append(mods, vs.d.where("visits.created_at", "<", *f.CreatedBefore))
+	}
+
+	// Continue right after the last record of the previous page
+	if f.PageToken != "" {
+		after, err := app.DecodeVisitPageToken(f.PageToken, orderBy, f.Descending)
+		if err != nil {
+			return nil, err
+		}
+
+		mod, err := vs.d.afterVisit(orderBy, f.Descending, after)
+		if err != nil {
+			return nil, app.ErrInvalidPageToken
+		}
+		mods = append(mods, mod)
+	}
+
+	direction := " ASC"
+	if f.Descending {
+		direction = " DESC"
+	}
+	if orderBy == app.VisitOrderByID {
+		mods = append(mods, qm.OrderBy(vs.d.quote("visits.id")+direction))
+	} else {
+		// Break ties by ID, so the order is stable across pages
+		mods = append(mods, qm.OrderBy(vs.d.quote("visits."+orderBy)+direction+", "+vs.d.quote("visits.id")+direction))
+	}
+
+	// Fetch an extra record to find out if there is a next page
+	mods = append(mods, qm.Limit(pageSize+1))
+
+	var rows []*visitRow
+	if err := vs.d.all(c, executor(c, vs.db.Reader(c)), &rows, visitsTable, mods...); err != nil {
+		return nil, err
+	}
+
+	page := &app.VisitPage{}
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		page.NextPageToken = app.EncodeVisitPageToken(orderBy, f.Descending, rowToVisit(rows[pageSize-1]))
+	}
+
+	page.Visits = make([]*app.Visit, len(rows))
+	for i, row := range rows {
+		page.Visits[i] = rowToVisit(row)
+	}
+
+	return page, nil
+}
+
+// tenantVisits scopes a visits query to the tenant of c, skipping deleted visits
+func (vs *visitService) tenantVisits(c context.Context, mods ...qm.QueryMod) ([]qm.QueryMod, error) {
+	return vs.d.tenantScope(c, visitsTable, append([]qm.QueryMod{qm.Where(vs.d.quote("visits.deleted_at") + " IS NULL")}, mods...)...)
+}
+
+func rowToVisit(row *visitRow) *app.Visit {
+	return &app.Visit{
+		ID:        row.ID,
+		FirstName: row.FirstName.String,
+		LastName:  row.LastName.String,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+		TenantID:  row.TenantID,
+		Version:   row.Version,
+	}
+}
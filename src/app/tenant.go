@@ -0,0 +1,23 @@
+package app
+
+import "context"
+
+// ErrTenantRequired is returned when a call isn't made on behalf of a tenant, see WithTenant
+var ErrTenantRequired = NewInvalidError("tenant", "tenant_id", "tenant ID is required")
+
+type tenantKey struct{}
+
+// WithTenant sets the tenant c acts on behalf of. Services only read and write the data of that tenant
+func WithTenant(c context.Context, tenantID string) context.Context {
+	return context.WithValue(c, tenantKey{}, tenantID)
+}
+
+// TenantFromContext returns the tenant set by WithTenant, ErrTenantRequired if none
+func TenantFromContext(c context.Context) (string, error) {
+	tenantID, ok := c.Value(tenantKey{}).(string)
+	if !ok {
+		return "", ErrTenantRequired
+	}
+
+	return tenantID, nil
+}
@@ -0,0 +1,47 @@
+package timeout
+
+import (
+	"context"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+)
+
+// NewAccountService decorates an AccountService with a deadline on every call.
+// Calls that run out of time fail with context.DeadlineExceeded
+func NewAccountService(next app.AccountService, timeout time.Duration) *accountService {
+	return &accountService{next: next, timeout: timeout}
+}
+
+type accountService struct {
+	next    app.AccountService
+	timeout time.Duration
+}
+
+func (as *accountService) Get(c context.Context, id uint) (*app.Account, error) {
+	c, cancel := context.WithTimeout(c, as.timeout)
+	defer cancel()
+
+	return as.next.Get(c, id)
+}
+
+func (as *accountService) GetByEmail(c context.Context, email string) (*app.Account, error) {
+	c, cancel := context.WithTimeout(c, as.timeout)
+	defer cancel()
+
+	return as.next.GetByEmail(c, email)
+}
+
+func (as *accountService) Create(c context.Context, a *app.Account) (*app.Account, error) {
+	c, cancel := context.WithTimeout(c, as.timeout)
+	defer cancel()
+
+	return as.next.Create(c, a)
+}
+
+func (as *accountService) SetPasswordHash(c context.Context, id uint, passwordHash string) (*app.Account, error) {
+	c, cancel := context.WithTimeout(c, as.timeout)
+	defer cancel()
+
+	return as.next.SetPasswordHash(c, id, passwordHash)
+}
@@ -0,0 +1,61 @@
+package timeout
+
+import (
+	"context"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+)
+
+// NewAPIKeyService decorates an APIKeyService with a deadline on every call.
+// Calls that run out of time fail with context.DeadlineExceeded
+func NewAPIKeyService(next app.APIKeyService, timeout time.Duration) *apiKeyService {
+	return &apiKeyService{next: next, timeout: timeout}
+}
+
+type apiKeyService struct {
+	next    app.APIKeyService
+	timeout time.Duration
+}
+
+func (ks *apiKeyService) Get(c context.Context, id uint) (*app.APIKey, error) {
+	c, cancel := context.WithTimeout(c, ks.timeout)
+	defer cancel()
+
+	return ks.next.Get(c, id)
+}
+
+func (ks *apiKeyService) GetByPrefix(c context.Context, prefix string) (*app.APIKey, error) {
+	c, cancel := context.WithTimeout(c, ks.timeout)
+	defer cancel()
+
+	return ks.next.GetByPrefix(c, prefix)
+}
+
+func (ks *apiKeyService) Create(c context.Context, k *app.APIKey) (*app.APIKey, error) {
+	c, cancel := context.WithTimeout(c, ks.timeout)
+	defer cancel()
+
+	return ks.next.Create(c, k)
+}
+
+func (ks *apiKeyService) SetSecret(c context.Context, id uint, prefix string, keyHash string) (*app.APIKey, error) {
+	c, cancel := context.WithTimeout(c, ks.timeout)
+	defer cancel()
+
+	return ks.next.SetSecret(c, id, prefix, keyHash)
+}
+
+func (ks *apiKeyService) Revoke(c context.Context, id uint) error {
+	c, cancel := context.WithTimeout(c, ks.timeout)
+	defer cancel()
+
+	return ks.next.Revoke(c, id)
+}
+
+func (ks *apiKeyService) TouchLastUsed(c context.Context, lastUsed map[uint]time.Time) error {
+	c, cancel := context.WithTimeout(c, ks.timeout)
+	defer cancel()
+
+	return ks.next.TouchLastUsed(c, lastUsed)
+}
@@ -0,0 +1,86 @@
+package timeout
+
+import (
+	"context"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+)
+
+// NewVisitService decorates a VisitService with a deadline on every call but Watch, which is long-lived.
+// Calls that run out of time fail with context.DeadlineExceeded
+func NewVisitService(next app.VisitService, timeout time.Duration) *visitService {
+	return &visitService{next: next, timeout: timeout}
+}
+
+type visitService struct {
+	next    app.VisitService
+	timeout time.Duration
+}
+
+func (vs *visitService) Get(c context.Context, id *uint) (*app.Visit, error) {
+	c, cancel := context.WithTimeout(c, vs.timeout)
+	defer cancel()
+
+	return vs.next.Get(c, id)
+}
+
+func (vs *visitService) Create(c context.Context, v *app.Visit) (*app.Visit, error) {
+	c, cancel := context.WithTimeout(c, vs.timeout)
+	defer cancel()
+
+	return vs.next.Create(c, v)
+}
+
+func (vs *visitService) Update(c context.Context, v *app.Visit, fields []string) (*app.Visit, error) {
+	c, cancel := context.WithTimeout(c, vs.timeout)
+	defer cancel()
+
+	return vs.next.Update(c, v, fields)
+}
+
+func (vs *visitService) List(c context.Context, f *app.VisitFilter) (*app.VisitPage, error) {
+	c, cancel := context.WithTimeout(c, vs.timeout)
+	defer cancel()
+
+	return vs.next.List(c, f)
+}
+
+func (vs *visitService) BatchGet(c context.Context, ids []uint) ([]*app.Visit, error) {
+	c, cancel := context.WithTimeout(c, vs.timeout)
+	defer cancel()
+
+	return vs.next.BatchGet(c, ids)
+}
+
+func (vs *visitService) BatchSet(c context.Context, visits []*app.Visit, atomic bool) ([]*app.VisitBatchResult, error) {
+	c, cancel := context.WithTimeout(c, vs.timeout)
+	defer cancel()
+
+	return vs.next.BatchSet(c, visits, atomic)
+}
+
+func (vs *visitService) Delete(c context.Context, id *uint) error {
+	c, cancel := context.WithTimeout(c, vs.timeout)
+	defer cancel()
+
+	return vs.next.Delete(c, id)
+}
+
+func (vs *visitService) Purge(c context.Context, id *uint) error {
+	c, cancel := context.WithTimeout(c, vs.timeout)
+	defer cancel()
+
+	return vs.next.Purge(c, id)
+}
+
+func (vs *visitService) ListAudit(c context.Context, visitID uint) ([]*app.VisitAudit, error) {
+	c, cancel := context.WithTimeout(c, vs.timeout)
+	defer cancel()
+
+	return vs.next.ListAudit(c, visitID)
+}
+
+func (vs *visitService) Watch(c context.Context, lastEventID uint64) (<-chan *app.VisitEvent, error) {
+	return vs.next.Watch(c, lastEventID)
+}
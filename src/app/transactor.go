@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// txRetryDelay is the delay before retrying a failed unit of work, doubled on every attempt
+const txRetryDelay = 10 * time.Millisecond
+
+// Transactor runs units of work that span several services, or several tables, in a single transaction
+type Transactor interface {
+	// WithinTx runs fn in a transaction, committed if fn succeeds and rolled back otherwise.
+	// Services called with fn's context join the transaction, a nested WithinTx joins it as well.
+	// fn may run more than once, if the transaction fails on a deadlock or a serialization error
+	WithinTx(c context.Context, fn func(c context.Context) error) error
+}
+
+type unitOfWorkKey struct{}
+
+type unitOfWork struct {
+	tx          boil.ContextTransactor
+	afterCommit []func()
+}
+
+// TxFromContext returns the transaction of the unit of work c belongs to, if any
+func TxFromContext(c context.Context) (boil.ContextTransactor, bool) {
+	uow, ok := c.Value(unitOfWorkKey{}).(*unitOfWork)
+	if !ok {
+		return nil, false
+	}
+
+	return uow.tx, true
+}
+
+// AfterCommit runs fn once the unit of work c belongs to is committed, it is dropped on rollback.
+// Without a unit of work, fn runs right away. Used for side effects that can't be rolled back (e.g events)
+func AfterCommit(c context.Context, fn func()) {
+	uow, ok := c.Value(unitOfWorkKey{}).(*unitOfWork)
+	if !ok {
+		fn()
+		return
+	}
+
+	uow.afterCommit = append(uow.afterCommit, fn)
+}
+
+// NewTransactor creates a Transactor over db, which should be the primary.
+// Failed units of work are retried while retryable reports true, up to maxAttempts runs overall,
+// e.g NewTransactor(db, mysql.IsRetryable, 3)
+func NewTransactor(db boil.ContextBeginner, retryable func(err error) bool, maxAttempts int) Transactor {
+	return &transactor{db: db, retryable: retryable, maxAttempts: maxAttempts}
+}
+
+type transactor struct {
+	db          boil.ContextBeginner
+	retryable   func(err error) bool
+	maxAttempts int
+}
+
+func (t *transactor) WithinTx(c context.Context, fn func(c context.Context) error) error {
+	// Join the outer unit of work, it's the one to commit and retry
+	if _, ok := TxFromContext(c); ok {
+		return fn(c)
+	}
+
+	delay := txRetryDelay
+	for attempt := 1; ; attempt++ {
+		uow, err := t.run(c, fn)
+		if err == nil {
+			for _, fn := range uow.afterCommit {
+				fn()
+			}
+			return nil
+		}
+
+		if attempt >= t.maxAttempts || !t.retryable(err) {
+			return err
+		}
+
+		select {
+		case <-c.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// run is a single attempt of the unit of work
+func (t *transactor) run(c context.Context, fn func(c context.Context) error) (*unitOfWork, error) {
+	tx, err := t.db.BeginTx(c, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	uow := &unitOfWork{tx: tx}
+	if err := fn(context.WithValue(c, unitOfWorkKey{}, uow)); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return uow, tx.Commit()
+}
@@ -0,0 +1,132 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var errRetryable = errors.New("deadlock")
+
+func newTxDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := db.Exec("CREATE TABLE items (id INTEGER NOT NULL PRIMARY KEY)"); err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func countItems(t *testing.T, db *sql.DB) int {
+	t.Helper()
+
+	var n int
+	if err := db.QueryRow("SELECT COUNT(*) FROM items").Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// insertItem inserts an item using the transaction of c
+func insertItem(c context.Context, id int) error {
+	tx, ok := TxFromContext(c)
+	if !ok {
+		return errors.New("no transaction")
+	}
+
+	_, err := tx.ExecContext(c, "INSERT INTO items (id) VALUES (?)", id)
+	return err
+}
+
+func TestTransactor_WithinTx(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxAttempts int
+		// Errors returned by the attempts after writing, nil once exhausted
+		errs         []error
+		wantErr      error
+		wantAttempts int
+		wantItems    int
+	}{
+		{name: "commit", maxAttempts: 3, wantAttempts: 1, wantItems: 1},
+		{name: "rollback", maxAttempts: 3, errs: []error{sql.ErrConnDone}, wantErr: sql.ErrConnDone, wantAttempts: 1},
+		{name: "retry", maxAttempts: 3, errs: []error{errRetryable, errRetryable}, wantAttempts: 3, wantItems: 1},
+		{name: "retries exhausted", maxAttempts: 2, errs: []error{errRetryable, errRetryable}, wantErr: errRetryable, wantAttempts: 2},
+		{name: "not retryable after retry", maxAttempts: 3, errs: []error{errRetryable, sql.ErrConnDone}, wantErr: sql.ErrConnDone, wantAttempts: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTxDB(t)
+			transactor := NewTransactor(db, func(err error) bool { return err == errRetryable }, tt.maxAttempts)
+
+			attempts, committed := 0, 0
+			err := transactor.WithinTx(context.Background(), func(c context.Context) error {
+				attempts++
+				if err := insertItem(c, 1); err != nil {
+					return err
+				}
+				AfterCommit(c, func() { committed++ })
+
+				if attempts <= len(tt.errs) {
+					return tt.errs[attempts-1]
+				}
+				return nil
+			})
+
+			if err != tt.wantErr {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, attempts)
+			}
+			if n := countItems(t, db); n != tt.wantItems {
+				t.Errorf("expected %d items, got %d", tt.wantItems, n)
+			}
+			// Only the committed attempt runs its callbacks
+			if want := tt.wantItems; committed != want {
+				t.Errorf("expected %d after commit callbacks, got %d", want, committed)
+			}
+		})
+	}
+}
+
+func TestTransactor_Nested(t *testing.T) {
+	db := newTxDB(t)
+	transactor := NewTransactor(db, func(err error) bool { return false }, 1)
+
+	// The nested unit of work joins the outer one, its write is rolled back along with it
+	err := transactor.WithinTx(context.Background(), func(c context.Context) error {
+		if err := transactor.WithinTx(c, func(c context.Context) error { return insertItem(c, 1) }); err != nil {
+			return err
+		}
+		return insertItem(c, 1)
+	})
+	if err == nil {
+		t.Fatal("expected the duplicate item to fail the unit of work")
+	}
+
+	if n := countItems(t, db); n != 0 {
+		t.Fatalf("expected the nested write to be rolled back, got %d items", n)
+	}
+}
+
+func TestAfterCommit_WithoutUnitOfWork(t *testing.T) {
+	ran := false
+	AfterCommit(context.Background(), func() { ran = true })
+
+	if !ran {
+		t.Fatal("expected AfterCommit to run right away without a unit of work")
+	}
+}
@@ -5,15 +5,86 @@ import (
 	"time"
 )
 
+// Visit list ordering
+const (
+	VisitOrderByID        = "id"
+	VisitOrderByFirstName = "first_name"
+	VisitOrderByLastName  = "last_name"
+	VisitOrderByCreatedAt = "created_at"
+)
+
+// Visit list page size limits
+const (
+	VisitListDefaultPageSize = 20
+	VisitListMaxPageSize     = 100
+)
+
+// Visit fields that can be updated
+const (
+	VisitFieldFirstName = "FirstName"
+	VisitFieldLastName  = "LastName"
+)
+
+// VisitBatchMaxSize is the max number of visits in a single batch call
+const VisitBatchMaxSize = 100
+
+// VisitResource is the resource type reported by visit errors
+const VisitResource = "visit"
+
+// ErrInvalidPageToken is returned when a page token can't be decoded or doesn't match the requested ordering
+var ErrInvalidPageToken = NewInvalidError(VisitResource, "page_token", "invalid page token")
+
 type Visit struct {
 	ID        uint      `json:"id" validate:"gte=0"`
 	FirstName string    `json:"first_name" validate:"required,gte=2,lte=254"`
 	LastName  string    `json:"last_name" validate:"required,gte=2,lte=254"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// Version is incremented on every update. On Update it's the expected version, 0 skips the check
+	Version uint `json:"version"`
+	// TenantID is set by the service, from the caller's context (see WithTenant)
+	TenantID string `json:"tenant_id"`
+}
+
+type VisitFilter struct {
+	FirstNamePrefix string     `json:"first_name_prefix" validate:"lte=254"`
+	LastNamePrefix  string     `json:"last_name_prefix" validate:"lte=254"`
+	CreatedAfter    *time.Time `json:"created_after"`
+	CreatedBefore   *time.Time `json:"created_before"`
+	OrderBy         string     `json:"order_by" validate:"omitempty,oneof=id first_name last_name created_at"`
+	Descending      bool       `json:"descending"`
+	PageSize        uint       `json:"page_size" validate:"lte=100"`
+	PageToken       string     `json:"page_token"`
+}
+
+type VisitPage struct {
+	Visits        []*Visit `json:"visits"`
+	NextPageToken string   `json:"next_page_token"`
+}
+
+// VisitBatchResult is the outcome of a single visit in a batch write
+type VisitBatchResult struct {
+	Visit *Visit
+	Err   error
 }
 
 type VisitService interface {
 	Get(c context.Context, id *uint) (*Visit, error)
-	Set(c context.Context, v *Visit) (*Visit, error)
+	Create(c context.Context, v *Visit) (*Visit, error)
+	// Update writes only the given fields (e.g VisitFieldFirstName), all updatable fields when none are given
+	Update(c context.Context, v *Visit, fields []string) (*Visit, error)
+	List(c context.Context, f *VisitFilter) (*VisitPage, error)
+	// BatchGet returns the found visits, in the requested order
+	BatchGet(c context.Context, ids []uint) ([]*Visit, error)
+	// BatchSet creates (ID 0) or fully updates each visit. Each visit succeeds or fails on its own,
+	// unless atomic is set; then nothing is written if any visit fails and its error is returned
+	BatchSet(c context.Context, visits []*Visit, atomic bool) ([]*VisitBatchResult, error)
+	// Delete soft deletes a visit, it is hidden from Get and List from now on
+	Delete(c context.Context, id *uint) error
+	// Purge permanently removes a visit, including soft deleted ones (e.g GDPR requests)
+	Purge(c context.Context, id *uint) error
+	// Watch streams visit changes, see VisitEvents.Watch
+	Watch(c context.Context, lastEventID uint64) (<-chan *VisitEvent, error)
+	// ListAudit returns the changes of a visit, oldest first. Deleted and purged visits keep their history
+	ListAudit(c context.Context, visitID uint) ([]*VisitAudit, error)
 }
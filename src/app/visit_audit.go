@@ -0,0 +1,102 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Visit audit actions
+const (
+	VisitAuditCreated = "created"
+	VisitAuditUpdated = "updated"
+	VisitAuditDeleted = "deleted"
+	// Purging also erases the values of the visit's earlier audit entries
+	VisitAuditPurged = "purged"
+)
+
+// Actor is who made a change, as recorded by the audit log
+type Actor struct {
+	Principal string `json:"principal"` // Empty if unknown
+	RequestID string `json:"request_id"`
+	TraceID   string `json:"trace_id"`
+}
+
+type actorKey struct{}
+
+// WithActor sets the actor of the changes made using c
+func WithActor(c context.Context, a Actor) context.Context {
+	return context.WithValue(c, actorKey{}, a)
+}
+
+// ActorFromContext returns the actor set by WithActor, the zero Actor if none
+func ActorFromContext(c context.Context) Actor {
+	a, _ := c.Value(actorKey{}).(Actor)
+	return a
+}
+
+// VisitAudit is a single change of a visit. OldValues and NewValues are JSON objects of the changed fields only,
+// OldValues is empty on creation and NewValues on deletion. Both are empty once the visit is purged
+type VisitAudit struct {
+	ID        uint64          `json:"id"`
+	VisitID   uint            `json:"visit_id"`
+	Action    string          `json:"action"`
+	Actor     Actor           `json:"actor"`
+	TenantID  string          `json:"tenant_id"`
+	OldValues json.RawMessage `json:"old_values"`
+	NewValues json.RawMessage `json:"new_values"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// VisitDiff returns the fields that differ between two versions of a visit, keyed by their JSON names.
+// A nil visit has no fields, all fields of the other one are returned
+func VisitDiff(old *Visit, new *Visit) (oldValues json.RawMessage, newValues json.RawMessage, err error) {
+	oldFields, err := visitFields(old)
+	if err != nil {
+		return nil, nil, err
+	}
+	newFields, err := visitFields(new)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for name, value := range oldFields {
+		if bytes.Equal(value, newFields[name]) {
+			delete(oldFields, name)
+			delete(newFields, name)
+		}
+	}
+
+	if oldValues, err = marshalFields(oldFields); err != nil {
+		return nil, nil, err
+	}
+	if newValues, err = marshalFields(newFields); err != nil {
+		return nil, nil, err
+	}
+
+	return oldValues, newValues, nil
+}
+
+func visitFields(v *Visit) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if v == nil {
+		return fields, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, json.Unmarshal(b, &fields)
+}
+
+// marshalFields returns nil for no fields
+func marshalFields(fields map[string]json.RawMessage) (json.RawMessage, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(fields)
+}
@@ -0,0 +1,77 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/pkg/broadcast"
+)
+
+// Visit event types
+const (
+	VisitEventCreated = "created"
+	VisitEventUpdated = "updated"
+	VisitEventDeleted = "deleted"
+)
+
+// Visit tasks, produced for every write through the outbox. Their single argument is the JSON encoded Visit
+const (
+	VisitTaskCreated = "visit.created"
+	VisitTaskUpdated = "visit.updated"
+	VisitTaskDeleted = "visit.deleted"
+)
+
+type VisitEvent struct {
+	ID        uint64    `json:"id"` // Increasing, used to resume watching
+	Type      string    `json:"type"`
+	Visit     *Visit    `json:"visit"` // Only the ID and TenantID are set for deleted visits
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewVisitEvents creates an in-process feed of visit changes, VisitService implementations publish their writes to it.
+// Every watcher buffers up to bufferSize events of its own tenant, so a busy tenant can't make the watchers of another lag behind.
+// Events are only seen by watchers of the same process, as are their IDs and history
+func NewVisitEvents(historySize int, bufferSize int) *VisitEvents {
+	return &VisitEvents{broadcast.New(historySize, bufferSize)}
+}
+
+type VisitEvents struct {
+	b *broadcast.Broadcaster
+}
+
+func (ve *VisitEvents) Publish(typ string, v *Visit) {
+	ve.b.Publish(v.TenantID, &VisitEvent{Type: typ, Visit: v, CreatedAt: time.Now()})
+}
+
+// Watch streams events published after lastEventID (0 for new events only), of the tenant of c only.
+// The channel is closed once c is done, or when the watcher lags behind; resume using the last event ID received
+func (ve *VisitEvents) Watch(c context.Context, lastEventID uint64) (<-chan *VisitEvent, error) {
+	tenantID, err := TenantFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := ve.b.Subscribe(c, tenantID, lastEventID)
+	if err == broadcast.ErrHistoryExpired {
+		return nil, NewInvalidError(VisitResource, "last_event_id", err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *VisitEvent)
+	go func() {
+		defer close(ch)
+		for e := range events {
+			event := *e.Payload.(*VisitEvent)
+			event.ID = e.ID
+
+			select {
+			case ch <- &event:
+			case <-c.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// visitPageToken is the opaque cursor handed out to clients, it points at the last visit of a page.
+// It's shared by the VisitService implementations, their pages are continued the same way
+type visitPageToken struct {
+	OrderBy    string `json:"o"`
+	Descending bool   `json:"d"`
+	Value      string `json:"v,omitempty"`
+	ID         uint   `json:"i"`
+}
+
+// EncodeVisitPageToken returns the token of the page that ends with v, listed by orderBy
+func EncodeVisitPageToken(orderBy string, descending bool, v *Visit) string {
+	t := &visitPageToken{OrderBy: orderBy, Descending: descending, ID: v.ID}
+
+	switch orderBy {
+	case VisitOrderByFirstName:
+		t.Value = v.FirstName
+	case VisitOrderByLastName:
+		t.Value = v.LastName
+	case VisitOrderByCreatedAt:
+		t.Value = v.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return t.encode()
+}
+
+// DecodeVisitPageToken returns the last visit of the previous page, only its ID and the field it's ordered by are set.
+// ErrInvalidPageToken is returned if the token can't be decoded, or it was listed by another order
+func DecodeVisitPageToken(token string, orderBy string, descending bool) (*Visit, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	t := &visitPageToken{}
+	if err := json.Unmarshal(b, t); err != nil || t.OrderBy != orderBy || t.Descending != descending {
+		return nil, ErrInvalidPageToken
+	}
+
+	v := &Visit{ID: t.ID}
+	switch t.OrderBy {
+	case VisitOrderByFirstName:
+		v.FirstName = t.Value
+	case VisitOrderByLastName:
+		v.LastName = t.Value
+	case VisitOrderByCreatedAt:
+		if v.CreatedAt, err = time.Parse(time.RFC3339Nano, t.Value); err != nil {
+			return nil, ErrInvalidPageToken
+		}
+	}
+
+	return v, nil
+}
+
+func (t *visitPageToken) encode() string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -2,21 +2,54 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/RichardKnop/machinery/v1"
+	machineryConfig "github.com/RichardKnop/machinery/v1/config"
 	"github.com/TheZeroSlave/zapsentry"
+	"github.com/afex/hystrix-go/hystrix"
 	metricCollector "github.com/afex/hystrix-go/hystrix/metric_collector"
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/app/cache"
+	"github.com/eldad87/go-boilerplate/src/app/identity"
+	"github.com/eldad87/go-boilerplate/src/app/memory"
 	service "github.com/eldad87/go-boilerplate/src/app/mysql"
+	"github.com/eldad87/go-boilerplate/src/app/postgres"
+	"github.com/eldad87/go-boilerplate/src/app/sqlite"
+	"github.com/eldad87/go-boilerplate/src/app/sqlstore"
+	"github.com/eldad87/go-boilerplate/src/app/timeout"
 	"github.com/eldad87/go-boilerplate/src/config"
+	"github.com/eldad87/go-boilerplate/src/pkg/admin"
+	reHystrix "github.com/eldad87/go-boilerplate/src/pkg/concurrency/hystrix"
+	machineryProducer "github.com/eldad87/go-boilerplate/src/pkg/task/producer/machinery"
 
 	//grpcGatewayError "github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/error"
+	gatewayAPIKey "github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/apikey"
+	gatewayClientCert "github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/clientcert"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/etag"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/requestid"
+	gatewayTenant "github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/tenant"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc/inprocess"
+	grpc_audit "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/audit"
+	grpc_auth "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/auth"
+	grpc_replica "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/replica"
+	grpc_status_app "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/status/app"
 	grpc_status_validator "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/status/validator.v10"
+	grpc_tenant "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/tenant"
 	grpc_validator "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/validator/protoc_gen_validate"
+	pkgHealthcheck "github.com/eldad87/go-boilerplate/src/pkg/healthcheck"
+	"github.com/eldad87/go-boilerplate/src/pkg/lifecycle"
+	"github.com/eldad87/go-boilerplate/src/pkg/prometheus/dbstats"
+	"github.com/eldad87/go-boilerplate/src/pkg/replica"
+	"github.com/eldad87/go-boilerplate/src/pkg/tlsconf"
 	promZap "github.com/eldad87/go-boilerplate/src/pkg/uber/zap"
 	grpcTransport "github.com/eldad87/go-boilerplate/src/transport/grpc"
 	pb "github.com/eldad87/go-boilerplate/src/transport/grpc/proto"
@@ -28,12 +61,15 @@ import (
 	sqlLogger "github.com/eldad87/go-boilerplate/src/pkg/go-sql-driver/logger"
 	databaseDriver "github.com/go-sql-driver/mysql"
 	"github.com/gobuffalo/packr"
+	"github.com/gomodule/redigo/redis"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
 	migrate "github.com/rubenv/sql-migrate"
 
 	sqlmwInterceptor "github.com/eldad87/go-boilerplate/src/pkg/ngrok/sqlmw"
 	"github.com/ngrok/sqlmw"
 
-	//"github.com/eldad87/go-boilerplate/src/pkg/crypto"
+	"github.com/eldad87/go-boilerplate/src/pkg/crypto"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -50,6 +86,7 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/soheilhy/cmux"
 	"github.com/uber/jaeger-client-go"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -69,13 +106,21 @@ func main() {
 		conf.Debug()
 	}
 
+	/*
+	 * PreRequisite: Admin
+	 * **************************** */
+	// Metrics, health checks, pprof etc are served on admin.port, apart from the public app.port
+	adminMux := http.NewServeMux()
+	admin.HandlePprof(adminMux, conf.GetString("admin.pprof.route"))
+	adminMux.HandleFunc(conf.GetString("admin.build_info.route"), admin.BuildInfoHandler)
+
 	/*
 	 * PreRequisite: Prometheus
 	 * **************************** */
 	collector := plugins.InitializePrometheusCollector(plugins.PrometheusCollectorConfig{
 		Namespace: conf.GetString("app.name"),
 	})
-	http.Handle(conf.GetString("prometheus.route"), promhttp.Handler())
+	adminMux.Handle(conf.GetString("prometheus.route"), promhttp.Handler())
 
 	/*
 	 * PreRequisite: Hystrix
@@ -86,12 +131,14 @@ func main() {
 	/*
 	 * PreRequisite: Health Check + Expose status Prometheus metrics gauge
 	 * **************************** */
-	healthChecker := healthcheck.NewMetricsHandler(prometheus.DefaultRegisterer, "health_check")
+	healthChecker := pkgHealthcheck.WithNonFatalChecks(healthcheck.NewMetricsHandler(prometheus.DefaultRegisterer, "health_check"))
 	healthChecker.AddLivenessCheck("Goroutine Threshold", healthcheck.GoroutineCountCheck(conf.GetInt("health_check.goroutine_threshold")))
 
 	// Expose to HTTP
-	http.HandleFunc(conf.GetString("health_check.route.group")+conf.GetString("health_check.route.live"), healthChecker.LiveEndpoint)
-	http.HandleFunc(conf.GetString("health_check.route.group")+conf.GetString("health_check.route.ready"), healthChecker.ReadyEndpoint)
+	liveRoute := conf.GetString("health_check.route.group") + conf.GetString("health_check.route.live")
+	readyRoute := conf.GetString("health_check.route.group") + conf.GetString("health_check.route.ready")
+	adminMux.HandleFunc(liveRoute, healthChecker.LiveEndpoint)
+	adminMux.HandleFunc(readyRoute, healthChecker.ReadyEndpoint)
 
 	/*
 	 * PreRequisite: Logger
@@ -103,6 +150,8 @@ func main() {
 	hook := promZap.MustNewPrometheusHook([]zapcore.Level{zapcore.DebugLevel, zapcore.InfoLevel, zapcore.WarnLevel,
 		zapcore.ErrorLevel, zapcore.FatalLevel, zapcore.PanicLevel, zapcore.DebugLevel})
 	logger, _ := zapConfig.Build(zap.Hooks(hook))
+	// GET the level, or PUT {"level":"info"} to change it at runtime
+	adminMux.Handle(conf.GetString("admin.log_level.route"), zapConfig.Level)
 
 	// Sentry
 	if conf.GetString("sentry.dsn") != "" {
@@ -125,7 +174,13 @@ func main() {
 		}
 		logger = zapsentry.AttachCoreToLogger(core, logger)
 	}
-	defer logger.Sync()
+
+	/*
+	 * PreRequisite: Lifecycle
+	 * **************************** */
+	// Shutdown on SIGINT/SIGTERM, readiness fails first. Hooks are appended at the bottom, see Shutdown
+	lc := lifecycle.New(logger, time.Duration(conf.GetInt("shutdown.readiness_delay"))*time.Millisecond)
+	healthChecker.AddReadinessCheck("shutdown", lc.ReadinessCheck)
 
 	/*
 	 * PreRequisite: Jaeger
@@ -149,7 +204,6 @@ func main() {
 			jaeger.ReporterOptions.Logger(logAdapt),
 		),
 	)
-	defer reporter.Close()
 
 	sampler := jaeger.NewConstSampler(true)
 	tracer, closer := jaeger.NewTracer(conf.GetString("app.name"),
@@ -157,63 +211,280 @@ func main() {
 		reporter,
 		jaeger.TracerOptions.Metrics(metrics),
 	)
-	defer closer.Close()
 	opentracing.SetGlobalTracer(tracer)
 
 	/*
 	 * PreRequisite: Other
 	 * **************************** */
-	//passwordHandler := crypto.Hash{}
+	passwordHandler := &crypto.Hash{}
+
+	validator := v10validator.New()
+	// Register valuer for guregu/null.v4
+	null_v4_validation.RegisterSQLNullValuer(validator)
 
 	/*
 	 * PreRequisite: DataBase
 	 * **************************** */
-	// Logger
-	databaseDriver.SetLogger(sqlLogger.NewLogger(logger))
-	// Tracer
-	mysqlInterceptor := sqlmwInterceptor.Interceptor{Tracer: tracer}
-	sql.Register("instrumented-mysql", sqlmw.Driver(databaseDriver.MySQLDriver{}, mysqlInterceptor))
-	db, err := sql.Open("instrumented-mysql", conf.GetString("database.dsn"))
-	if err != nil {
-		logger.Sugar().Fatal("Database failed to listen: %v. Due to error: %v", conf.GetString("database.dsn"), err)
+	// SQL drivers by database.driver, along with their dialect and migrations folder (MySQL's are at the root).
+	// The memory driver keeps everything in-process, no database is used
+	sqlDrivers := map[string]struct {
+		driver     driver.Driver
+		dialect    *sqlstore.Dialect
+		migrations string
+		lag        replica.LagFunc // Read replicas are supported if set
+	}{
+		"mysql":    {databaseDriver.MySQLDriver{}, service.Dialect, "", replica.MySQLLag},
+		"postgres": {&pq.Driver{}, postgres.Dialect, "postgres", replica.PostgresLag},
+		"sqlite3":  {&sqlite3.SQLiteDriver{}, sqlite.Dialect, "sqlite3", nil},
 	}
 
-	if err := db.Ping(); err != nil {
-		logger.Sugar().Errorf("Database failed to Ping: %v. Due to error: %v", conf.GetString("database.dsn"), err)
+	var db *sql.DB
+	var dbRouter *replica.Router
+	var dbDialect *sqlstore.Dialect
+	var dbTransactor app.Transactor
+	// Deadline of every service call, 0 disables it
+	queryTimeout := time.Duration(conf.GetInt("database.query_timeout")) * time.Millisecond
+	databaseDriverName := conf.GetString("database.driver")
+	if databaseDriverName != "memory" {
+		sqlDriver, ok := sqlDrivers[databaseDriverName]
+		if !ok {
+			logger.Fatal("Unsupported database driver", zap.String("database.driver", databaseDriverName))
+		}
+		dbDialect = sqlDriver.dialect
+
+		// Logger
+		databaseDriver.SetLogger(sqlLogger.NewLogger(logger))
+		// Tracer
+		sqlInterceptor := sqlmwInterceptor.Interceptor{Tracer: tracer}
+		sql.Register("instrumented-"+databaseDriverName, sqlmw.Driver(sqlDriver.driver, sqlInterceptor))
+		db, err = sql.Open("instrumented-"+databaseDriverName, conf.GetString("database.dsn"))
+		if err != nil {
+			logger.Sugar().Fatal("Database failed to listen: %v. Due to error: %v", conf.GetString("database.dsn"), err)
+		}
+
+		// Connection pool, applied to the primary and replicas alike
+		setPool := func(name string, db *sql.DB) {
+			db.SetMaxOpenConns(conf.GetInt("database.pool.max_open_conns"))
+			db.SetMaxIdleConns(conf.GetInt("database.pool.max_idle_conns"))
+			db.SetConnMaxLifetime(time.Duration(conf.GetInt("database.pool.conn_max_lifetime")) * time.Millisecond)
+			// SQLite has a single writer, it's also the only way to share an in-memory database
+			if databaseDriverName == "sqlite3" {
+				db.SetMaxOpenConns(1)
+			}
+
+			if err := prometheus.Register(dbstats.NewCollector(name, db)); err != nil {
+				logger.Error("Failed to register database stats", zap.String("db_name", name), zap.Error(err))
+			}
+		}
+		setPool("primary", db)
+
+		if err := db.Ping(); err != nil {
+			logger.Sugar().Errorf("Database failed to Ping: %v. Due to error: %v", conf.GetString("database.dsn"), err)
+		}
+		// Our app is not ready if we can't connect to our database (`var db *sql.DB`) in <1s.
+		healthChecker.AddReadinessCheck(databaseDriverName, healthcheck.DatabasePingCheck(db, 1*time.Second))
+
+		// Read replicas
+		var replicasConf []struct {
+			Name string
+			DSN  string
+		}
+		if err := conf.UnmarshalKey("database.replicas", &replicasConf); err != nil {
+			logger.Fatal("Failed to parse database replicas", zap.Error(err))
+		}
+		if len(replicasConf) > 0 && sqlDriver.lag == nil {
+			logger.Fatal("Database driver doesn't support replicas", zap.String("database.driver", databaseDriverName))
+		}
+
+		var replicas []*replica.Replica
+		for _, replicaConf := range replicasConf {
+			replicaDB, err := sql.Open("instrumented-"+databaseDriverName, replicaConf.DSN)
+			if err != nil {
+				logger.Fatal("Database replica failed to open", zap.String("replica", replicaConf.Name), zap.Error(err))
+			}
+			setPool(replicaConf.Name, replicaDB)
+
+			replicas = append(replicas, &replica.Replica{Name: replicaConf.Name, DB: replicaDB})
+		}
+
+		dbRouter = replica.New(db, replicas, time.Duration(conf.GetInt("database.replica.max_lag"))*time.Millisecond, sqlDriver.lag)
+		// Unhealthy replicas aren't a readiness failure, reads fall back to the primary
+		for _, r := range replicas {
+			r := r
+			healthChecker.AddNonFatalReadinessCheck(databaseDriverName+"-"+r.Name, func() error { return dbRouter.Check(r) })
+		}
+		if err := prometheus.Register(replica.NewCollector(dbRouter)); err != nil {
+			logger.Error("Failed to register database replica metrics", zap.Error(err))
+		}
+		// Units of work of the services, retried on deadlocks
+		dbTransactor = app.NewTransactor(db, dbDialect.IsRetryable, conf.GetInt("database.tx.max_attempts"))
+		lc.Go(func(c context.Context) {
+			dbRouter.Monitor(c, time.Duration(conf.GetInt("database.replica.check_interval"))*time.Millisecond)
+		})
+
+		// Migration
+		if conf.GetString("database.auto_migrate") == "on" {
+			migrations := &migrate.PackrMigrationSource{
+				Box: packr.NewBox("../../../src/migration"),
+				Dir: sqlDriver.migrations,
+			}
+			n, err := migrate.Exec(db, databaseDriverName, migrations, migrate.Up)
+			if err != nil {
+				logger.Error("Error applying migration:", zap.Error(err))
+			}
+			logger.Debug("Applied migrations:", zap.Int("attempt", n))
+		}
 	}
-	// Our app is not ready if we can't connect to our database (`var db *sql.DB`) in <1s.
-	healthChecker.AddReadinessCheck(conf.GetString("database.driver"), healthcheck.DatabasePingCheck(db, 1*time.Second))
 
-	// Migration
-	if conf.GetString("database.auto_migrate") == "on" {
-		migrations := &migrate.PackrMigrationSource{
-			Box: packr.NewBox("../../../src/migration"),
+	/*
+	 * PreRequisite: Auth
+	 * **************************** */
+	// Disabled unless a JWT secret or JWKS is configured, API keys or client certificates are enabled
+	var authenticators []grpc_auth.Authenticator
+	if conf.GetString("auth.jwt.hs256_secret") != "" || conf.GetString("auth.jwt.jwks") != "" {
+		jwtConf := grpc_auth.JWTConfig{
+			Secret:   []byte(conf.GetString("auth.jwt.hs256_secret")),
+			Issuer:   conf.GetString("auth.jwt.issuer"),
+			Audience: conf.GetString("auth.jwt.audience"),
+		}
+
+		if jwks := conf.GetString("auth.jwt.jwks"); jwks != "" {
+			jwtConf.Keys, err = grpc_auth.NewKeySet(context.Background(), jwks)
+			if err != nil {
+				logger.Fatal("Failed to load JWKS", zap.String("auth.jwt.jwks", jwks), zap.Error(err))
+			}
+
+			keys := jwtConf.Keys
+			lc.Go(func(c context.Context) {
+				keys.RefreshEvery(c, time.Duration(conf.GetInt("auth.jwt.jwks_refresh_interval"))*time.Millisecond, func(err error) {
+					logger.Error("Failed to refresh JWKS", zap.String("auth.jwt.jwks", jwks), zap.Error(err))
+				})
+			})
 		}
-		n, err := migrate.Exec(db, conf.GetString("database.driver"), migrations, migrate.Up)
+
+		authenticators = append(authenticators, grpc_auth.NewJWTAuthenticator(jwtConf))
+	}
+
+	var apiKeyManager *identity.APIKeyManager
+	if conf.GetBool("auth.api_key.enabled") {
+		var apiKeyService app.APIKeyService
+		if db == nil {
+			apiKeyService = memory.NewAPIKeyService()
+		} else {
+			apiKeyService = sqlstore.NewAPIKeyService(dbDialect, db, dbTransactor)
+		}
+		if queryTimeout > 0 {
+			apiKeyService = timeout.NewAPIKeyService(apiKeyService, queryTimeout)
+		}
+
+		apiKeyManager = identity.NewAPIKeyManager(apiKeyService, validator, []byte(conf.GetString("auth.api_key.hash_key")))
+		authenticators = append(authenticators, grpcTransport.NewAPIKeyAuthenticator(apiKeyManager))
+
+		lc.Go(func(c context.Context) {
+			apiKeyManager.Run(c, time.Duration(conf.GetInt("auth.api_key.last_used_interval"))*time.Millisecond, func(err error) {
+				logger.Error("Failed to write API keys last used time", zap.Error(err))
+			})
+		})
+	}
+
+	// Mutual TLS, requires tls.client_ca_file
+	if conf.GetBool("auth.client_cert.enabled") {
+		authenticators = append(authenticators, grpc_auth.NewClientCertAuthenticator(conf.GetStringSlice("auth.client_cert.scopes")))
+	}
+
+	// Strict mode without authenticators would deny every method that isn't public
+	if conf.GetBool("auth.strict") && len(authenticators) == 0 {
+		logger.Fatal("auth.strict requires an authenticator: a JWT secret or JWKS, API keys or client certificates")
+	}
+
+	/*
+	 * PreRequisite: TLS
+	 * **************************** */
+	// Of both gRPC and HTTP, certificates are reloaded once changed on disk
+	var tlsConfig *tls.Config
+	if conf.GetBool("tls.enabled") {
+		minVersion, err := tlsconf.ParseVersion(conf.GetString("tls.min_version"))
 		if err != nil {
-			logger.Error("Error applying migration:", zap.Error(err))
+			logger.Fatal("Invalid TLS version", zap.String("tls.min_version", conf.GetString("tls.min_version")), zap.Error(err))
 		}
-		logger.Debug("Applied migrations:", zap.Int("attempt", n))
+
+		certs, err := tlsconf.NewReloader(tlsconf.Config{
+			CertFile:          conf.GetString("tls.cert_file"),
+			KeyFile:           conf.GetString("tls.key_file"),
+			ClientCAFile:      conf.GetString("tls.client_ca_file"),
+			RequireClientCert: conf.GetBool("tls.require_client_cert"),
+			MinVersion:        minVersion,
+		})
+		if err != nil {
+			logger.Fatal("Failed to load TLS certificates", zap.Error(err))
+		}
+		tlsConfig = certs.ServerConfig()
+
+		lc.Go(func(c context.Context) {
+			certs.Run(c, time.Duration(conf.GetInt("tls.reload_interval"))*time.Millisecond, func(err error) {
+				logger.Error("Failed to reload TLS certificates", zap.Error(err))
+			})
+		})
 	}
 
 	/*
 	 * PreRequisite: gRPC
 	 * **************************** */
-	lis, err := net.Listen("tcp", ":"+conf.GetString("app.grpc.port"))
-	if err != nil || lis == nil {
-		logger.Sugar().Errorf("gRPC failed to listen: %v", err)
+	// Single port: gRPC and HTTP share app.port, gRPC requests are told apart by their content-type.
+	// Over TLS, the HTTP server serves gRPC as well, see publicHandler
+	grpcPort := conf.GetString("app.grpc.port")
+	singlePortTLS := conf.GetBool("app.single_port") && tlsConfig != nil
+	var portMux cmux.CMux
+	var lis, httpLis net.Listener
+	if conf.GetBool("app.single_port") {
+		grpcPort = conf.GetString("app.port")
+		rootLis, err := net.Listen("tcp", ":"+grpcPort)
+		if err != nil {
+			logger.Fatal("Failed to listen", zap.String("port", grpcPort), zap.Error(err))
+		}
+
+		if singlePortTLS {
+			httpLis = tls.NewListener(rootLis, tlsConfig)
+		} else {
+			portMux = cmux.New(rootLis)
+			// Some gRPC clients (e.g Java) wait for the server's SETTINGS before sending any header
+			lis = portMux.MatchWithWriters(cmux.HTTP2MatchHeaderFieldPrefixSendSettings("content-type", "application/grpc"))
+			httpLis = portMux.Match(cmux.Any())
+		}
+	} else {
+		lis, err = net.Listen("tcp", ":"+grpcPort)
+		if err != nil || lis == nil {
+			logger.Sugar().Errorf("gRPC failed to listen: %v", err)
+		} else if tlsConfig != nil {
+			lis = tls.NewListener(lis, tlsConfig)
+		}
+
+		httpLis, err = net.Listen("tcp", ":"+conf.GetString("app.port"))
+		if err != nil {
+			logger.Fatal("HTTP failed to listen", zap.String("port", conf.GetString("app.port")), zap.Error(err))
+		}
+		if tlsConfig != nil {
+			httpLis = tls.NewListener(httpLis, tlsConfig)
+		}
 	}
-	logger.Info("gRPC is about to start listening for incoming requests", zap.String("port", conf.GetString("app.grpc.port")))
+	logger.Info("gRPC is about to start listening for incoming requests", zap.String("port", grpcPort))
 
 	grpcServer := grpc.NewServer(
+		// Handshakes TLS, when enabled, and lets in-process connections through
+		grpc.Creds(inprocess.Credentials()),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			grpc_ctxtags.StreamServerInterceptor(),
 			grpc_opentracing.StreamServerInterceptor(grpc_opentracing.WithTracer(tracer)),
 			grpc_prometheus.StreamServerInterceptor,
 			grpc_zap.StreamServerInterceptor(logger),
 			grpc_recovery.StreamServerInterceptor(),
+			grpc_auth.StreamServerInterceptor(conf.GetBool("auth.strict"), authenticators...),
+			grpc_tenant.StreamServerInterceptor(conf.GetBool("app.tenant.required")),
+			grpc_replica.StreamServerInterceptor(),
+			grpc_audit.StreamServerInterceptor(),
 			grpc_validator.StreamServerInterceptor(),
 			grpc_status_validator.StreamServerInterceptor(),
+			grpc_status_app.StreamServerInterceptor(),
 		)),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			grpc_ctxtags.UnaryServerInterceptor(),
@@ -221,35 +492,166 @@ func main() {
 			grpc_prometheus.UnaryServerInterceptor,
 			grpc_zap.UnaryServerInterceptor(logger),
 			grpc_recovery.UnaryServerInterceptor(),
+			grpc_auth.UnaryServerInterceptor(conf.GetBool("auth.strict"), authenticators...),
+			grpc_tenant.UnaryServerInterceptor(conf.GetBool("app.tenant.required")),
+			grpc_replica.UnaryServerInterceptor(),
+			grpc_audit.UnaryServerInterceptor(),
 			grpc_validator.UnaryServerInterceptor(),
 			grpc_status_validator.UnaryServerInterceptor(),
+			grpc_status_app.UnaryServerInterceptor(),
 		)),
 	)
-	defer grpcServer.GracefulStop()
-
-	validator := v10validator.New()
-	// Register valuer for guregu/null.v4
-	null_v4_validation.RegisterSQLNullValuer(validator)
 
 	// Visit Service
-	visitService := service.NewVisitService(db, validator)
+	visitEvents := app.NewVisitEvents(conf.GetInt("app.visit_events.history_size"), conf.GetInt("app.visit_events.buffer_size"))
+	var visitService app.VisitService
+	if db == nil {
+		visitService = memory.NewVisitService(validator, visitEvents)
+	} else {
+		visitService = sqlstore.NewVisitService(dbDialect, dbRouter, dbTransactor, validator, visitEvents)
+	}
+
+	// Query deadline, Redis has timeouts of its own
+	if queryTimeout > 0 {
+		visitService = timeout.NewVisitService(visitService, queryTimeout)
+	}
+
+	// Read-through cache
+	if conf.GetString("cache.redis.dsn") != "" {
+		redisTimeout := time.Duration(conf.GetInt("cache.redis.timeout")) * time.Millisecond
+		redisPool := &redis.Pool{
+			MaxIdle:     conf.GetInt("cache.redis.max_idle"),
+			IdleTimeout: 240 * time.Second,
+			Dial: func() (redis.Conn, error) {
+				return redis.DialURL(conf.GetString("cache.redis.dsn"),
+					redis.DialConnectTimeout(redisTimeout),
+					redis.DialReadTimeout(redisTimeout),
+					redis.DialWriteTimeout(redisTimeout),
+				)
+			},
+		}
+		defer redisPool.Close()
+		healthChecker.AddReadinessCheck("redis", pkgHealthcheck.RedisCheck(conf.GetString("cache.redis.dsn"), redisTimeout))
+
+		visitService, err = cache.NewVisitService(visitService, redisPool,
+			time.Duration(conf.GetInt("cache.visit.ttl"))*time.Millisecond,
+			time.Duration(conf.GetInt("cache.visit.load_timeout"))*time.Millisecond,
+			prometheus.DefaultRegisterer,
+		)
+		if err != nil {
+			logger.Sugar().Fatalf("Failed to create visit cache: %v", err)
+		}
+	}
 	grpcVisitServer := grpcTransport.VisitServer{VisitService: visitService}
 
+	// Identity Service
+	var grpcIdentityServer *grpcTransport.IdentityServer
+	if conf.GetBool("app.identity.enabled") {
+		if conf.GetString("auth.jwt.hs256_secret") == "" {
+			logger.Fatal("Identity requires auth.jwt.hs256_secret to sign tokens")
+		}
+
+		var accountService app.AccountService
+		if db == nil {
+			accountService = memory.NewAccountService()
+		} else {
+			accountService = sqlstore.NewAccountService(dbDialect, db, dbTransactor)
+		}
+		if queryTimeout > 0 {
+			accountService = timeout.NewAccountService(accountService, queryTimeout)
+		}
+
+		tokenIssuer := identity.NewTokenIssuer(identity.TokenConfig{
+			Secret:     []byte(conf.GetString("auth.jwt.hs256_secret")),
+			Issuer:     conf.GetString("auth.jwt.issuer"),
+			Audience:   conf.GetString("auth.jwt.audience"),
+			AccessTTL:  time.Duration(conf.GetInt64("app.identity.access_token_ttl")) * time.Millisecond,
+			RefreshTTL: time.Duration(conf.GetInt64("app.identity.refresh_token_ttl")) * time.Millisecond,
+			Scopes:     conf.GetStringSlice("app.identity.scopes"),
+		})
+		loginLimiter := identity.NewLoginLimiter(conf.GetInt("app.identity.login.max_failures"), time.Duration(conf.GetInt("app.identity.login.lockout"))*time.Millisecond)
+		identityService := identity.NewIdentityService(accountService, validator, passwordHandler, tokenIssuer, loginLimiter, identity.RegistrationConfig{
+			Tenants:      conf.GetStringSlice("app.identity.registration.tenants"),
+			DefaultRoles: conf.GetStringSlice("app.identity.registration.default_roles"),
+		})
+		grpcIdentityServer = &grpcTransport.IdentityServer{IdentityService: identityService}
+	}
+
+	/*
+	 * Outbox: Relay tasks written by our services to machinery
+	 * **************************** */
+	if db != nil && conf.GetString("machinery.broker_dsn") != "" {
+		machineryServer, err := machinery.NewServer(&machineryConfig.Config{
+			Broker:        conf.GetString("machinery.broker_dsn"),
+			DefaultQueue:  conf.GetString("machinery.default_queue"),
+			ResultBackend: conf.GetString("machinery.result_backend_dsn"),
+			AMQP: &machineryConfig.AMQPConfig{
+				Exchange:      conf.GetString("machinery.exchange"),
+				ExchangeType:  conf.GetString("machinery.exchange_type"),
+				BindingKey:    conf.GetString("machinery.binding_key"),
+				PrefetchCount: conf.GetInt("machinery.consumer.prefetch_count"),
+			},
+		})
+		if err != nil {
+			logger.Sugar().Fatalf("Machinery failed to start: %v", err)
+		}
+
+		taskProducer := machineryProducer.NewProducer(machineryServer, hystrix.CommandConfig{
+			Timeout:                conf.GetInt("machinery.broker.timeout"),
+			MaxConcurrentRequests:  conf.GetInt("machinery.broker.max_conn"),
+			RequestVolumeThreshold: conf.GetInt("machinery.broker.vol_threshold"),
+			SleepWindow:            conf.GetInt("machinery.broker.sleep_window"),
+			ErrorPercentThreshold:  conf.GetInt("machinery.broker.err_per_threshold"),
+		}, reHystrix.RetryConfig{
+			Attempts: conf.GetInt("machinery.broker.retries"),
+			Delay:    time.Duration(conf.GetInt("machinery.broker.retry_delay")),
+		})
+
+		outboxRelay := sqlstore.NewOutboxRelay(dbDialect, db, dbTransactor, taskProducer, logger, sqlstore.OutboxRelayConfig{
+			Interval:      time.Duration(conf.GetInt("outbox.relay.interval")) * time.Millisecond,
+			BatchSize:     conf.GetInt("outbox.relay.batch_size"),
+			RetryDelay:    time.Duration(conf.GetInt("outbox.relay.retry_delay")) * time.Millisecond,
+			MaxRetryDelay: time.Duration(conf.GetInt("outbox.relay.max_retry_delay")) * time.Millisecond,
+			Timeout:       time.Duration(conf.GetInt("outbox.relay.timeout")) * time.Millisecond,
+		})
+		lc.Go(outboxRelay.Run)
+	} else if db != nil {
+		logger.Warn("Machinery broker isn't configured, outbox tasks won't be relayed")
+	}
+
 	pb.RegisterVisitServer(grpcServer, &grpcVisitServer)
+	if grpcIdentityServer != nil {
+		pb.RegisterIdentityServer(grpcServer, grpcIdentityServer)
+	}
+	if apiKeyManager != nil {
+		pb.RegisterAPIKeyServer(grpcServer, &grpcTransport.APIKeyServer{APIKeys: apiKeyManager})
+	}
 
 	// Start listening to gRPC requests
-	go func() {
-		if err := grpcServer.Serve(lis); err != nil {
-			logger.Error("gRPC failed listening for incoming requests",
-				zap.String("port", conf.GetString("app.grpc.port")),
-				zap.String("error", err.Error()),
-			)
+	if lis != nil {
+		go func() {
+			if err := grpcServer.Serve(lis); err != nil {
+				// Single port: the shared listener is closed along with HTTP
+				if lc.ReadinessCheck() != nil {
+					return
+				}
+
+				logger.Error("gRPC failed listening for incoming requests",
+					zap.String("port", grpcPort),
+					zap.String("error", err.Error()),
+				)
 
-			healthChecker.AddReadinessCheck("gRPC", func() error { return err }) // Permanent, take us down.
-		} else {
-			logger.Info("gRPC is listening for incoming requests", zap.String("port", conf.GetString("app.grpc.port")))
-		}
-	}()
+				healthChecker.AddReadinessCheck("gRPC", func() error { return err }) // Permanent, take us down.
+			} else {
+				logger.Info("gRPC is listening for incoming requests", zap.String("port", grpcPort))
+			}
+		}()
+	}
+
+	// The gateway calls gRPC in-process, over memory rather than the network. RegisterVisitHandlerServer isn't used
+	// on purpose: it calls the server directly, skipping the interceptors above, and doesn't support streams (e.g Watch)
+	inProcessLis := inprocess.NewListener()
+	go grpcServer.Serve(inProcessLis)
 
 	/*
 	 * gRPC: gRPC as HTTP Gateway
@@ -258,6 +660,7 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	publicMux := http.NewServeMux()
 	mux := runtime.NewServeMux(
 		runtime.WithMetadata(
 			func(ctx context.Context, r *http.Request) metadata.MD {
@@ -276,6 +679,19 @@ func main() {
 				return metadata.New(carrier)
 			},
 		),
+		// Optimistic concurrency: If-Match as metadata, version as ETag
+		runtime.WithMetadata(etag.Metadata),
+		// Request ID, recorded by the audit log
+		runtime.WithMetadata(requestid.Metadata),
+		runtime.WithMetadata(gatewayTenant.Metadata),
+		// The Authorization header is forwarded as authorization metadata by default
+		runtime.WithMetadata(gatewayAPIKey.Metadata),
+		// Mutual TLS, the client certificate of the HTTP request. Clients can't set it themselves
+		runtime.WithMetadata(gatewayClientCert.Metadata),
+		runtime.WithIncomingHeaderMatcher(gatewayClientCert.HeaderMatcher),
+		runtime.WithForwardResponseOption(etag.ForwardResponseOption),
+		// A version that doesn't match If-Match is 412 Precondition Failed
+		runtime.WithErrorHandler(etag.ErrorHandler(nil, app.ReasonVersionMismatch)),
 		// Customize our error response
 		// runtime.WithErrorHandler(grpcGatewayError.CustomHTTPError),
 	)
@@ -289,27 +705,160 @@ func main() {
 		}),
 	)
 
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	err = pb.RegisterVisitHandlerFromEndpoint(ctx, mux, ":"+conf.GetString("app.grpc.port"), opts)
+	// The same credentials as the server's, in-process connections skip TLS
+	gatewayConn, err := grpc.DialContext(ctx, "in-process",
+		grpc.WithTransportCredentials(inprocess.Credentials()),
+		grpc.WithContextDialer(inProcessLis.DialContext),
+	)
+	if err != nil {
+		logger.Fatal("Failed to dial gRPC in-process", zap.Error(err))
+	}
+
+	err = pb.RegisterVisitHandler(ctx, mux, gatewayConn)
 	if err != nil {
 		logger.Sugar().Errorf("Failed to register Visit Service %+v", err)
 	}
 
-	http.HandleFunc(conf.GetString("app.grpc.http_route_prefix")+"/", muxHandlerFunc)
+	if apiKeyManager != nil {
+		err = pb.RegisterAPIKeyHandler(ctx, mux, gatewayConn)
+		if err != nil {
+			logger.Sugar().Errorf("Failed to register API Key Service %+v", err)
+		}
+	}
+
+	publicMux.HandleFunc(conf.GetString("app.grpc.http_route_prefix")+"/", muxHandlerFunc)
+
+	if grpcIdentityServer != nil {
+		err = pb.RegisterIdentityHandler(ctx, mux, gatewayConn)
+		if err != nil {
+			logger.Sugar().Errorf("Failed to register Identity Service %+v", err)
+		}
+
+		publicMux.HandleFunc(conf.GetString("app.identity.http_route_prefix")+"/", muxHandlerFunc)
+	}
 
 	// Swagger
 	if conf.GetString("environment") == "development" {
 		// Serve swagger.json
 		box := swaggerui.GetBox()
-		http.Handle(conf.GetString("swagger.ui.route.group"), http.StripPrefix(conf.GetString("swagger.ui.route.group"), http.FileServer(box)))
+		publicMux.Handle(conf.GetString("swagger.ui.route.group"), http.StripPrefix(conf.GetString("swagger.ui.route.group"), http.FileServer(box)))
 
 		fs := http.FileServer(http.Dir("src/transport/grpc/proto"))
-		http.Handle(conf.GetString("swagger.json.route.group")+"/", http.StripPrefix(conf.GetString("swagger.json.route.group"), fs))
+		publicMux.Handle(conf.GetString("swagger.json.route.group")+"/", http.StripPrefix(conf.GetString("swagger.json.route.group"), fs))
 	}
 
 	/*
 	 * Start listening for incoming HTTP requests
 	 * **************************** */
 	logger.Info("Starting on port " + conf.GetString("app.port"))
-	http.ListenAndServe(":"+conf.GetString("app.port"), nil)
+	publicHandler := http.Handler(publicMux)
+	if singlePortTLS {
+		publicHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+				grpcServer.ServeHTTP(w, r)
+				return
+			}
+
+			publicMux.ServeHTTP(w, r)
+		})
+	}
+
+	httpServer := &http.Server{Addr: ":" + conf.GetString("app.port"), Handler: publicHandler}
+	go func() {
+		if err := httpServer.Serve(httpLis); err != nil && err != http.ErrServerClosed {
+			logger.Error("HTTP failed listening for incoming requests", zap.String("port", conf.GetString("app.port")), zap.Error(err))
+			lc.Shutdown()
+		}
+	}()
+
+	if portMux != nil {
+		go func() {
+			if err := portMux.Serve(); err != nil && lc.ReadinessCheck() == nil {
+				logger.Error("Failed serving the shared port", zap.String("port", grpcPort), zap.Error(err))
+				lc.Shutdown()
+			}
+		}()
+	}
+
+	/*
+	 * Start listening for incoming admin requests
+	 * **************************** */
+	adminCredentials := admin.Credentials{
+		Username: conf.GetString("admin.auth.username"),
+		Password: conf.GetString("admin.auth.password"),
+		Token:    conf.GetString("admin.auth.token"),
+	}
+	if adminCredentials.Username != "" && adminCredentials.Password == "" {
+		logger.Fatal("admin.auth.username requires admin.auth.password")
+	}
+
+	adminHandler := http.NewServeMux()
+	adminHandler.Handle("/", admin.Protect(adminCredentials, adminMux))
+	if !conf.GetBool("admin.auth.health") {
+		// Left open for probes, e.g Kubernetes
+		adminHandler.Handle(liveRoute, adminMux)
+		adminHandler.Handle(readyRoute, adminMux)
+	}
+
+	logger.Info("Admin starting on port " + conf.GetString("admin.port"))
+	adminServer := &http.Server{Addr: ":" + conf.GetString("admin.port"), Handler: adminHandler}
+	go func() {
+		if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("Admin failed listening for incoming requests", zap.String("port", conf.GetString("admin.port")), zap.Error(err))
+			lc.Shutdown()
+		}
+	}()
+
+	/*
+	 * Shutdown: Hooks run in order, once readiness failed for shutdown.readiness_delay
+	 * **************************** */
+	lc.Append("HTTP", time.Duration(conf.GetInt("shutdown.http_timeout"))*time.Millisecond, func(c context.Context) error {
+		// In-flight gateway requests are done, or cut
+		defer gatewayConn.Close()
+		return httpServer.Shutdown(c)
+	})
+	lc.Append("gRPC", time.Duration(conf.GetInt("shutdown.grpc_timeout"))*time.Millisecond, func(c context.Context) error {
+		if singlePortTLS {
+			// Requests were served by the HTTP server, and drained along with it. GracefulStop can't drain them
+			grpcServer.Stop()
+			return nil
+		}
+
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			return nil
+		case <-c.Done():
+			// Cut whatever is left, e.g Watch streams
+			grpcServer.Stop()
+			return c.Err()
+		}
+	})
+	// Background workers, e.g the outbox relay, may still be using the database
+	lc.Append("workers", time.Duration(conf.GetInt("shutdown.workers_timeout"))*time.Millisecond, lc.StopWorkers)
+	// Up until now, to report readiness and metrics while draining
+	lc.Append("admin", time.Duration(conf.GetInt("shutdown.http_timeout"))*time.Millisecond, adminServer.Shutdown)
+	lc.Append("Jaeger", time.Duration(conf.GetInt("shutdown.flush_timeout"))*time.Millisecond, func(c context.Context) error {
+		// Closes the reporter as well, flushing the spans it holds
+		return closer.Close()
+	})
+	lc.Append("zap", time.Duration(conf.GetInt("shutdown.flush_timeout"))*time.Millisecond, func(c context.Context) error {
+		logger.Sync()
+		return nil
+	})
+	if db != nil {
+		lc.Append("database", time.Duration(conf.GetInt("shutdown.flush_timeout"))*time.Millisecond, func(c context.Context) error {
+			for _, r := range dbRouter.Replicas() {
+				r.DB.Close()
+			}
+			return db.Close()
+		})
+	}
+
+	lc.Wait()
 }
@@ -14,6 +14,7 @@ func GetConfig(env string, confFiles map[string]string) (*viper.Viper, error) {
 	// Defaults: App
 	conf.SetDefault("app.name", "default")
 	conf.SetDefault("app.port", "8080")
+	conf.SetDefault("app.single_port", false) // Serve gRPC on app.port as well, app.grpc.port is unused
 	conf.SetDefault("app.grpc.port", "8082")
 	conf.SetDefault("app.grpc.http_route_prefix", "/v1")
 
@@ -23,8 +24,66 @@ func GetConfig(env string, confFiles map[string]string) (*viper.Viper, error) {
 	conf.SetDefault("app.request.sleep_window", 5000)
 	conf.SetDefault("app.request.err_per_threshold", 50)
 
-	// Identity app
-	// conf.SetDefault("app.identity.http_route_prefix", "/auth")
+	// Visit changes feed, used by Watch
+	conf.SetDefault("app.visit_events.history_size", 1000) // Events kept to resume from
+	conf.SetDefault("app.visit_events.buffer_size", 100)   // Per watcher, slower watchers are disconnected
+
+	// Tenants, sent as x-tenant-id metadata (X-Tenant-Id over HTTP).
+	// Unless required, requests without one use the default (empty) tenant, which owns the data written before tenants.
+	// When required, credentials must be bound to a tenant (JWT tid claim, API keys), client certificates can't be used
+	conf.SetDefault("app.tenant.required", false)
+
+	// Defaults: Auth, enabled once a JWT secret or JWKS is set. Tokens are sent as "authorization: Bearer" metadata
+	// Methods are guarded by their (auth.rules) proto option. In strict mode, methods without one are denied
+	conf.SetDefault("auth.strict", false)
+	conf.SetDefault("auth.jwt.hs256_secret", "")
+	conf.SetDefault("auth.jwt.jwks", "")                       // RS256 keys, a file path or an http(s) URL
+	conf.SetDefault("auth.jwt.jwks_refresh_interval", 3600000) // ms
+	conf.SetDefault("auth.jwt.issuer", "")                     // Optional
+	conf.SetDefault("auth.jwt.audience", "")                   // Optional
+	// API keys, sent as x-api-key metadata (X-Api-Key over HTTP). Only their HMAC is stored, changing hash_key invalidates them all
+	conf.SetDefault("auth.api_key.enabled", false)
+	conf.SetDefault("auth.api_key.hash_key", "")
+	conf.SetDefault("auth.api_key.last_used_interval", 60000) // ms, last used times are written in batches
+	conf.SetDefault("auth.client_cert.enabled", false)        // Mutual TLS, see tls.client_ca_file. CN is the subject, OUs the roles
+	conf.SetDefault("auth.client_cert.scopes", []string{})    // Granted to all client certificates
+
+	// Identity app, issues the JWTs accepted by auth. Requires auth.jwt.hs256_secret to sign them
+	conf.SetDefault("app.identity.enabled", false)
+	conf.SetDefault("app.identity.http_route_prefix", "/auth")
+	conf.SetDefault("app.identity.access_token_ttl", 900000)      // ms
+	conf.SetDefault("app.identity.refresh_token_ttl", 2592000000) // ms
+	conf.SetDefault("app.identity.scopes", []string{"visit.read", "visit.write"})
+	conf.SetDefault("app.identity.registration.tenants", []string{})       // Tenants open to self-registration, "*" for any
+	conf.SetDefault("app.identity.registration.default_roles", []string{}) // Of registered accounts
+	conf.SetDefault("app.identity.login.max_failures", 5)                  // Attempts per email without logging in, then it's locked out
+	conf.SetDefault("app.identity.login.lockout", 900000)                  // ms
+
+	// Defaults: TLS, of both gRPC and HTTP
+	conf.SetDefault("tls.enabled", false)
+	conf.SetDefault("tls.cert_file", "")
+	conf.SetDefault("tls.key_file", "")
+	conf.SetDefault("tls.client_ca_file", "")         // PEM bundle, enables mutual TLS
+	conf.SetDefault("tls.require_client_cert", false) // Otherwise a client certificate is verified only if sent
+	conf.SetDefault("tls.min_version", "1.2")         // 1.0, 1.1, 1.2 or 1.3
+	conf.SetDefault("tls.reload_interval", 10000)     // ms, between checks of the files for changes
+
+	// Defaults: Shutdown, on SIGINT/SIGTERM
+	conf.SetDefault("shutdown.readiness_delay", 5000) // ms, readiness fails for that long before the servers stop
+	conf.SetDefault("shutdown.http_timeout", 10000)   // ms, to drain in-flight HTTP requests
+	conf.SetDefault("shutdown.grpc_timeout", 10000)   // ms, to drain in-flight RPCs. Streams left are cut
+	conf.SetDefault("shutdown.workers_timeout", 5000) // ms, e.g the outbox relay
+	conf.SetDefault("shutdown.flush_timeout", 5000)   // ms, per flush (Jaeger, zap, database)
+
+	// Defaults: Admin, metrics, health checks, pprof etc. Apart from the public app.port
+	conf.SetDefault("admin.port", "8081")
+	conf.SetDefault("admin.auth.username", "") // Basic auth, along with admin.auth.password
+	conf.SetDefault("admin.auth.password", "")
+	conf.SetDefault("admin.auth.token", "")     // Bearer token, basic auth is accepted as well if set
+	conf.SetDefault("admin.auth.health", false) // Protect the health checks too, they're left open for probes by default
+	conf.SetDefault("admin.pprof.route", "/debug/pprof/")
+	conf.SetDefault("admin.log_level.route", "/log/level")
+	conf.SetDefault("admin.build_info.route", "/build-info")
 
 	// Defaults: Monitoring
 	conf.SetDefault("log.level", "debug")
@@ -45,9 +104,24 @@ func GetConfig(env string, confFiles map[string]string) (*viper.Viper, error) {
 	conf.SetDefault("swagger.json.route.group", "/swagger")
 
 	// Defaults: DataBase
-	conf.SetDefault("database.driver", "")
-	conf.SetDefault("database.dsn", "") // If you use the MySQL driver with existing database client, you must create the client with parameter multiStatements=true:
+	conf.SetDefault("database.driver", "mysql") // mysql, postgres, sqlite3 (e.g file:boilerplate.db?_loc=UTC), or memory to run without a database
+	conf.SetDefault("database.dsn", "")         // If you use the MySQL driver with existing database client, you must create the client with parameter multiStatements=true:
 	conf.SetDefault("database.auto_migrate", "off")
+	conf.SetDefault("database.replicas", []map[string]string{}) // Reads are spread across replicas, e.g [{name: replica-1, dsn: ...}]
+	conf.SetDefault("database.replica.max_lag", 1000)           // ms, replicas lagging behind are skipped
+	conf.SetDefault("database.replica.check_interval", 1000)    // ms
+	conf.SetDefault("database.pool.max_open_conns", 0)          // 0 is unlimited, sqlite3 always uses a single connection
+	conf.SetDefault("database.pool.max_idle_conns", 2)
+	conf.SetDefault("database.pool.conn_max_lifetime", 0) // ms, 0 keeps connections forever
+	conf.SetDefault("database.query_timeout", 5000)       // ms, per service call, 0 disables it
+	conf.SetDefault("database.tx.max_attempts", 3)        // Runs of a transaction that failed on a deadlock or a serialization error
+
+	// Defaults: Cache, disabled unless a Redis DSN is set
+	conf.SetDefault("cache.redis.dsn", "") // e.g redis://redis:6379/0
+	conf.SetDefault("cache.redis.max_idle", 10)
+	conf.SetDefault("cache.redis.timeout", 100)       // ms, per Redis command
+	conf.SetDefault("cache.visit.ttl", 60000)         // ms
+	conf.SetDefault("cache.visit.load_timeout", 5000) // ms, of reading a missing visit, shared by concurrent misses
 
 	// Defaults: Machinery
 	conf.SetDefault("machinery.broker_dsn", "")
@@ -70,6 +144,13 @@ func GetConfig(env string, confFiles map[string]string) (*viper.Viper, error) {
 	conf.SetDefault("machinery.consumer.concurrent_tasks", 10)
 	conf.SetDefault("machinery.consumer.prefetch_count", 1)
 
+	// Defaults: Outbox, relays tasks written along with our data to machinery
+	conf.SetDefault("outbox.relay.interval", 1000)         // ms, between polls once the outbox is drained
+	conf.SetDefault("outbox.relay.batch_size", 100)        // Tasks per transaction
+	conf.SetDefault("outbox.relay.retry_delay", 1000)      // ms, doubled on every failed attempt
+	conf.SetDefault("outbox.relay.max_retry_delay", 60000) // ms
+	conf.SetDefault("outbox.relay.timeout", 30000)         // ms, per batch, 0 disables it
+
 	// Conf Env
 	conf.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "_", "__")) // APP_DATA__BASE_PASS -> app.data_base.pass
 	conf.AutomaticEnv()                                              // Automatically load Env variables
@@ -9,6 +9,8 @@ import (
 	promZap "github.com/eldad87/go-boilerplate/src/pkg/uber/zap"
 	databaseDriver "github.com/go-sql-driver/mysql"
 	"github.com/gobuffalo/packr"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/magefile/mage/mg"
 	"github.com/rubenv/sql-migrate"
 	"go.uber.org/zap"
@@ -44,7 +46,7 @@ func (DB) Migrate() error {
 	 * **************************** */
 	// Logger
 	databaseDriver.SetLogger(sqlLogger.NewLogger(logger))
-	db, err := sql.Open("mysql", conf.GetString("database.dsn"))
+	db, err := sql.Open(conf.GetString("database.driver"), conf.GetString("database.dsn"))
 	if err != nil {
 		logger.Sugar().Fatal("Database failed to listen: %v. Due to error: %v", conf.GetString("database.dsn"), err)
 	}
@@ -56,6 +58,10 @@ func (DB) Migrate() error {
 	migrations := &migrate.PackrMigrationSource{
 		Box: packr.NewBox("../../src/migration"),
 	}
+	// MySQL migrations are at the root, other dialects have their own folder
+	if conf.GetString("database.driver") != "mysql" {
+		migrations.Dir = conf.GetString("database.driver")
+	}
 	appliedMigrations, err := migrate.Exec(db, conf.GetString("database.driver"), migrations, migrate.Up)
 	if err != nil {
 		logger.Error("Error applying migration: ", zap.Error(err))
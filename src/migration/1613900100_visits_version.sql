@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE visits ADD COLUMN version int UNSIGNED NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE visits DROP COLUMN version;
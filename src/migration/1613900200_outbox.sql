@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE outbox (
+    id bigint UNSIGNED AUTO_INCREMENT,
+    task_name varchar(255) NOT NULL,
+    payload json NOT NULL,
+    attempts int UNSIGNED NOT NULL DEFAULT 0,
+    last_error text,
+    available_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    KEY outbox_available_at (available_at)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE visit_audit (
+    id bigint UNSIGNED AUTO_INCREMENT,
+    visit_id int UNSIGNED NOT NULL,
+    action varchar(16) NOT NULL,
+    principal varchar(255) NOT NULL DEFAULT '',
+    request_id varchar(255) NOT NULL DEFAULT '',
+    trace_id varchar(64) NOT NULL DEFAULT '',
+    old_values json,
+    new_values json,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    KEY visit_audit_visit_id (visit_id, id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS visit_audit;
@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE visits
+    ADD COLUMN tenant_id varchar(64) NOT NULL DEFAULT '',
+    ADD KEY visits_tenant_id (tenant_id, id);
+ALTER TABLE visit_audit
+    ADD COLUMN tenant_id varchar(64) NOT NULL DEFAULT '',
+    DROP KEY visit_audit_visit_id,
+    ADD KEY visit_audit_tenant_id_visit_id (tenant_id, visit_id, id);
+
+-- +migrate Down
+ALTER TABLE visit_audit
+    DROP KEY visit_audit_tenant_id_visit_id,
+    ADD KEY visit_audit_visit_id (visit_id, id),
+    DROP COLUMN tenant_id;
+ALTER TABLE visits
+    DROP KEY visits_tenant_id,
+    DROP COLUMN tenant_id;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE accounts (
+    id int UNSIGNED AUTO_INCREMENT,
+    tenant_id varchar(64) NOT NULL DEFAULT '',
+    email varchar(254) NOT NULL,
+    password_hash varchar(255) NOT NULL,
+    roles varchar(255) NOT NULL DEFAULT '',
+    token_version int UNSIGNED NOT NULL DEFAULT 0,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY accounts_tenant_id_email (tenant_id, email)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS accounts;
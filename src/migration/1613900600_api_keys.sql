@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE api_keys (
+    id int UNSIGNED AUTO_INCREMENT,
+    tenant_id varchar(64) NOT NULL DEFAULT '',
+    name varchar(255) NOT NULL,
+    prefix varchar(32) NOT NULL,
+    key_hash varchar(64) NOT NULL,
+    scopes varchar(1024) NOT NULL DEFAULT '',
+    roles varchar(255) NOT NULL DEFAULT '',
+    expires_at timestamp NULL DEFAULT NULL,
+    last_used_at timestamp NULL DEFAULT NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at timestamp NULL DEFAULT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY api_keys_prefix (prefix),
+    KEY api_keys_tenant_id (tenant_id, id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS api_keys;
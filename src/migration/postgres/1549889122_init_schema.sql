@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE visits (
+    id serial,
+    first_name varchar(255),
+    last_name varchar(255),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS visits;
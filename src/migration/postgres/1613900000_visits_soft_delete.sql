@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE visits ADD COLUMN deleted_at timestamptz NULL DEFAULT NULL;
+
+-- +migrate Down
+ALTER TABLE visits DROP COLUMN deleted_at;
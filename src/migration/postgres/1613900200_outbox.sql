@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE outbox (
+    id bigserial,
+    task_name varchar(255) NOT NULL,
+    payload jsonb NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text,
+    available_at timestamptz NOT NULL DEFAULT NOW(),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+);
+CREATE INDEX outbox_available_at ON outbox (available_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE visit_audit (
+    id bigserial,
+    visit_id integer NOT NULL,
+    action varchar(16) NOT NULL,
+    principal varchar(255) NOT NULL DEFAULT '',
+    request_id varchar(255) NOT NULL DEFAULT '',
+    trace_id varchar(64) NOT NULL DEFAULT '',
+    old_values jsonb,
+    new_values jsonb,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+);
+CREATE INDEX visit_audit_visit_id ON visit_audit (visit_id, id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS visit_audit;
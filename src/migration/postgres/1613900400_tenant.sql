@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE visits ADD COLUMN tenant_id varchar(64) NOT NULL DEFAULT '';
+CREATE INDEX visits_tenant_id ON visits (tenant_id, id);
+ALTER TABLE visit_audit ADD COLUMN tenant_id varchar(64) NOT NULL DEFAULT '';
+DROP INDEX visit_audit_visit_id;
+CREATE INDEX visit_audit_tenant_id_visit_id ON visit_audit (tenant_id, visit_id, id);
+
+-- +migrate Down
+DROP INDEX visit_audit_tenant_id_visit_id;
+CREATE INDEX visit_audit_visit_id ON visit_audit (visit_id, id);
+ALTER TABLE visit_audit DROP COLUMN tenant_id;
+DROP INDEX visits_tenant_id;
+ALTER TABLE visits DROP COLUMN tenant_id;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE accounts (
+    id serial,
+    tenant_id varchar(64) NOT NULL DEFAULT '',
+    email varchar(254) NOT NULL,
+    password_hash varchar(255) NOT NULL,
+    roles varchar(255) NOT NULL DEFAULT '',
+    token_version integer NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX accounts_tenant_id_email ON accounts (tenant_id, email);
+
+-- +migrate Down
+DROP TABLE IF EXISTS accounts;
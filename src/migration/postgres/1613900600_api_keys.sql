@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE api_keys (
+    id serial,
+    tenant_id varchar(64) NOT NULL DEFAULT '',
+    name varchar(255) NOT NULL,
+    prefix varchar(32) NOT NULL,
+    key_hash varchar(64) NOT NULL,
+    scopes varchar(1024) NOT NULL DEFAULT '',
+    roles varchar(255) NOT NULL DEFAULT '',
+    expires_at timestamptz NULL DEFAULT NULL,
+    last_used_at timestamptz NULL DEFAULT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz NULL DEFAULT NULL,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX api_keys_prefix ON api_keys (prefix);
+CREATE INDEX api_keys_tenant_id ON api_keys (tenant_id, id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,11 @@
+-- +migrate Up
+CREATE TABLE visits (
+    id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    first_name VARCHAR(255),
+    last_name VARCHAR(255),
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS visits;
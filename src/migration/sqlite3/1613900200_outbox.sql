@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE outbox (
+    id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    task_name VARCHAR(255) NOT NULL,
+    payload JSON NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    available_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX outbox_available_at ON outbox (available_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS outbox;
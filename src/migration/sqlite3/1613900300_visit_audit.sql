@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE visit_audit (
+    id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    visit_id INTEGER NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    principal VARCHAR(255) NOT NULL DEFAULT '',
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    trace_id VARCHAR(64) NOT NULL DEFAULT '',
+    old_values JSON,
+    new_values JSON,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX visit_audit_visit_id ON visit_audit (visit_id, id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS visit_audit;
@@ -0,0 +1,65 @@
+package admin
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// Credentials protect the admin endpoints, with basic auth, a bearer token, or both.
+// The zero Credentials leave them unprotected
+type Credentials struct {
+	Username string
+	Password string
+	Token    string
+}
+
+// Enabled reports whether any credentials are set
+func (cr Credentials) Enabled() bool {
+	return cr.Username != "" || cr.Token != ""
+}
+
+// Protect rejects requests to next that don't carry cr, with 401
+func Protect(cr Credentials, next http.Handler) http.Handler {
+	if !cr.Enabled() {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cr.allowed(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if cr.Username != "" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="admin"`)
+		} else {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+		}
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+	})
+}
+
+func (cr Credentials) allowed(r *http.Request) bool {
+	if cr.Token != "" {
+		auth := r.Header.Get("Authorization")
+		if len(auth) > len("Bearer ") && strings.EqualFold(auth[:len("Bearer ")], "Bearer ") {
+			return equal(auth[len("Bearer "):], cr.Token)
+		}
+	}
+
+	if cr.Username != "" {
+		if username, password, ok := r.BasicAuth(); ok {
+			// Both are compared, to take the same time whichever is wrong
+			usernameOK := equal(username, cr.Username)
+			passwordOK := equal(password, cr.Password)
+			return usernameOK && passwordOK
+		}
+	}
+
+	return false
+}
+
+func equal(given string, expected string) bool {
+	return subtle.ConstantTimeCompare([]byte(given), []byte(expected)) == 1
+}
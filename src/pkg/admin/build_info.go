@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+)
+
+// Set at build time, e.g
+// go build -ldflags "-X github.com/eldad87/go-boilerplate/src/pkg/admin.Version=1.2.0 -X github.com/eldad87/go-boilerplate/src/pkg/admin.Commit=$(git rev-parse HEAD)"
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildTime = ""
+)
+
+// BuildInfo describes the running binary
+type BuildInfo struct {
+	Version   string            `json:"version"`
+	Commit    string            `json:"commit,omitempty"`
+	BuildTime string            `json:"build_time,omitempty"`
+	GoVersion string            `json:"go_version"`
+	Module    string            `json:"module,omitempty"`
+	Deps      map[string]string `json:"deps,omitempty"` // Module path -> version
+}
+
+// GetBuildInfo returns the build info of the running binary
+func GetBuildInfo() BuildInfo {
+	bi := BuildInfo{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		bi.Module = info.Main.Path
+		bi.Deps = make(map[string]string, len(info.Deps))
+		for _, dep := range info.Deps {
+			if dep.Replace != nil {
+				dep = dep.Replace
+			}
+			bi.Deps[dep.Path] = dep.Version
+		}
+	}
+
+	return bi
+}
+
+// BuildInfoHandler serves GetBuildInfo as JSON
+func BuildInfoHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(GetBuildInfo())
+}
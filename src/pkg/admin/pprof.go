@@ -0,0 +1,23 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"strings"
+)
+
+// HandlePprof serves net/http/pprof under prefix (e.g /debug/pprof/) of mux.
+// Importing net/http/pprof registers it on http.DefaultServeMux as well, don't serve that mux publicly
+func HandlePprof(mux *http.ServeMux, prefix string) {
+	prefix = strings.TrimSuffix(prefix, "/")
+
+	// Index serves the named profiles (e.g heap, goroutine), it expects them under /debug/pprof/
+	mux.Handle(prefix+"/", http.StripPrefix(prefix, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.URL.Path = "/debug/pprof" + r.URL.Path
+		pprof.Index(w, r)
+	})))
+	mux.HandleFunc(prefix+"/cmdline", pprof.Cmdline)
+	mux.HandleFunc(prefix+"/profile", pprof.Profile)
+	mux.HandleFunc(prefix+"/symbol", pprof.Symbol)
+	mux.HandleFunc(prefix+"/trace", pprof.Trace)
+}
@@ -0,0 +1,116 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrHistoryExpired is returned when subscribing after an event that is no longer kept in history
+var ErrHistoryExpired = errors.New("event is no longer available")
+
+type Event struct {
+	ID      uint64
+	Topic   string
+	Payload interface{}
+}
+
+// Broadcaster fans out published events to the subscribers of their topic, in-process.
+// Event IDs are shared by all topics, and the last historySize events are kept, so subscribers can resume after a disconnect.
+// Every subscriber buffers up to bufferSize events of its own topic, a busy topic never drops the subscribers of another
+func New(historySize int, bufferSize int) *Broadcaster {
+	return &Broadcaster{
+		historySize: historySize,
+		bufferSize:  bufferSize,
+		subscribers: make(map[string]map[chan *Event]struct{}),
+	}
+}
+
+type Broadcaster struct {
+	mu          sync.Mutex
+	lastID      uint64
+	history     []*Event
+	historySize int
+	bufferSize  int
+	subscribers map[string]map[chan *Event]struct{}
+}
+
+// Publish an event to the subscribers of topic, returns its ID.
+// Subscribers that can't keep up are dropped (their channel is closed), they're expected to resume using the last ID they've seen
+func (b *Broadcaster) Publish(topic string, payload interface{}) uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID++
+	e := &Event{ID: b.lastID, Topic: topic, Payload: payload}
+
+	b.history = append(b.history, e)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for ch := range b.subscribers[topic] {
+		select {
+		case ch <- e:
+		default:
+			b.drop(topic, ch)
+		}
+	}
+
+	return e.ID
+}
+
+// Subscribe to the events of topic published after lastID (0 for new events only).
+// The channel is closed once ctx is done, or if the subscriber lags behind
+func (b *Broadcaster) Subscribe(ctx context.Context, topic string, lastID uint64) (<-chan *Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []*Event
+	if lastID > 0 {
+		if lastID > b.lastID || (len(b.history) > 0 && lastID+1 < b.history[0].ID) {
+			return nil, ErrHistoryExpired
+		}
+
+		for _, e := range b.history {
+			if e.ID > lastID && e.Topic == topic {
+				replay = append(replay, e)
+			}
+		}
+	}
+
+	ch := make(chan *Event, len(replay)+b.bufferSize)
+	for _, e := range replay {
+		ch <- e
+	}
+	if b.subscribers[topic] == nil {
+		b.subscribers[topic] = make(map[chan *Event]struct{})
+	}
+	b.subscribers[topic][ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(topic, ch)
+	}()
+
+	return ch, nil
+}
+
+func (b *Broadcaster) unsubscribe(topic string, ch chan *Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Might have been dropped already
+	if _, ok := b.subscribers[topic][ch]; ok {
+		b.drop(topic, ch)
+	}
+}
+
+// drop closes the channel of a subscriber, b.mu must be held
+func (b *Broadcaster) drop(topic string, ch chan *Event) {
+	delete(b.subscribers[topic], ch)
+	if len(b.subscribers[topic]) == 0 {
+		delete(b.subscribers, topic)
+	}
+	close(ch)
+}
@@ -0,0 +1,77 @@
+package broadcast
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestBroadcaster_Subscribe(t *testing.T) {
+	tests := []struct {
+		name string
+		// published before subscribing, then after, as topic:payload pairs
+		before, after [][2]string
+		lastID        uint64
+		wantErr       error
+		wantPayloads  []string
+		wantDropped   bool
+	}{
+		{name: "new events only", before: [][2]string{{"acme", "1"}}, after: [][2]string{{"acme", "2"}}, wantPayloads: []string{"2"}},
+		{name: "resume", before: [][2]string{{"acme", "1"}, {"acme", "2"}}, after: [][2]string{{"acme", "3"}}, lastID: 1, wantPayloads: []string{"2", "3"}},
+		{name: "resume, other topics aren't replayed", before: [][2]string{{"acme", "1"}, {"globex", "2"}, {"acme", "3"}}, lastID: 1, wantPayloads: []string{"3"}},
+		{name: "other topics", after: [][2]string{{"globex", "1"}, {"acme", "2"}}, wantPayloads: []string{"2"}},
+		{name: "busy other topic doesn't drop the subscriber", after: [][2]string{{"globex", "1"}, {"globex", "2"}, {"globex", "3"}, {"acme", "4"}}, wantPayloads: []string{"4"}},
+		{name: "lagging subscriber is dropped", after: [][2]string{{"acme", "1"}, {"acme", "2"}, {"acme", "3"}}, wantPayloads: []string{"1", "2"}, wantDropped: true},
+		{name: "expired history", before: [][2]string{{"acme", "1"}, {"acme", "2"}, {"acme", "3"}, {"acme", "4"}, {"acme", "5"}}, lastID: 1, wantErr: ErrHistoryExpired},
+		{name: "future ID", before: [][2]string{{"acme", "1"}}, lastID: 2, wantErr: ErrHistoryExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New(3, 2)
+			for _, e := range tt.before {
+				b.Publish(e[0], e[1])
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events, err := b.Subscribe(ctx, "acme", tt.lastID)
+			if err != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			for _, e := range tt.after {
+				b.Publish(e[0], e[1])
+			}
+
+			payloads := []string{}
+			for len(payloads) < len(tt.wantPayloads) {
+				e, ok := <-events
+				if !ok {
+					break
+				}
+				payloads = append(payloads, e.Payload.(string))
+			}
+			if !reflect.DeepEqual(payloads, tt.wantPayloads) {
+				t.Fatalf("expected %v, got %v", tt.wantPayloads, payloads)
+			}
+
+			// Only a lagging subscriber's channel is closed, the others are still open with nothing left to read
+			dropped := false
+			select {
+			case e, ok := <-events:
+				if ok {
+					t.Fatalf("unexpected event %+v", e)
+				}
+				dropped = true
+			default:
+			}
+			if dropped != tt.wantDropped {
+				t.Errorf("expected dropped %v, got %v", tt.wantDropped, dropped)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package apikey
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata key that carries the HTTP X-Api-Key header
+const MetadataKey = "x-api-key"
+
+// Metadata forwards the X-Api-Key header as gRPC metadata, use with runtime.WithMetadata
+func Metadata(ctx context.Context, r *http.Request) metadata.MD {
+	if key := r.Header.Get("X-Api-Key"); key != "" {
+		return metadata.Pairs(MetadataKey, key)
+	}
+
+	return nil
+}
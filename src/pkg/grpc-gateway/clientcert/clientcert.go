@@ -0,0 +1,34 @@
+package clientcert
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata key that carries the verified client certificate of an HTTP request,
+// base64 encoded DER. It must only be trusted from the in-process gateway
+const MetadataKey = "x-client-cert"
+
+// Metadata forwards the client certificate verified by the TLS listener as gRPC metadata, use with runtime.WithMetadata
+func Metadata(ctx context.Context, r *http.Request) metadata.MD {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	return metadata.Pairs(MetadataKey, base64.StdEncoding.EncodeToString(r.TLS.VerifiedChains[0][0].Raw))
+}
+
+// HeaderMatcher is runtime.DefaultHeaderMatcher, except that clients can't forward their own certificate as
+// Grpc-Metadata-X-Client-Cert. Use with runtime.WithIncomingHeaderMatcher
+func HeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == textproto.CanonicalMIMEHeaderKey(runtime.MetadataHeaderPrefix+MetadataKey) {
+		return "", false
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,107 @@
+package etag
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// MetadataKey is the gRPC metadata key that carries the HTTP If-Match header
+const MetadataKey = "if-match"
+
+type versioned interface {
+	GetVersion() uint32
+}
+
+// Metadata forwards the If-Match header as gRPC metadata, use with runtime.WithMetadata
+func Metadata(ctx context.Context, r *http.Request) metadata.MD {
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		return metadata.Pairs(MetadataKey, ifMatch)
+	}
+
+	return nil
+}
+
+// ForwardResponseOption sets the ETag header of responses that carry a version, use with runtime.WithForwardResponseOption
+func ForwardResponseOption(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+	if v, ok := resp.(versioned); ok && v.GetVersion() > 0 {
+		w.Header().Set("ETag", Format(v.GetVersion()))
+	}
+
+	return nil
+}
+
+// ErrorHandler responds with 412 Precondition Failed when the expected version doesn't match, use with runtime.WithErrorHandler.
+// Such errors are FailedPrecondition, with an errdetails.ErrorInfo of versionMismatchReason; other FailedPrecondition errors
+// remain 400. Any error is handled by next, runtime.DefaultHTTPErrorHandler if nil
+func ErrorHandler(next runtime.ErrorHandlerFunc, versionMismatchReason string) runtime.ErrorHandlerFunc {
+	if next == nil {
+		next = runtime.DefaultHTTPErrorHandler
+	}
+
+	return func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		if isVersionMismatch(err, versionMismatchReason) {
+			w = &preconditionFailedWriter{w}
+		}
+
+		next(ctx, mux, marshaler, w, r, err)
+	}
+}
+
+func isVersionMismatch(err error, reason string) bool {
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition {
+		return false
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == reason {
+			return true
+		}
+	}
+
+	return false
+}
+
+// preconditionFailedWriter replaces the status code of FailedPrecondition, 400 by default
+type preconditionFailedWriter struct {
+	http.ResponseWriter
+}
+
+func (w *preconditionFailedWriter) WriteHeader(code int) {
+	if code == http.StatusBadRequest {
+		code = http.StatusPreconditionFailed
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Format a version as a strong ETag, e.g "3"
+func Format(version uint32) string {
+	return strconv.Quote(strconv.FormatUint(uint64(version), 10))
+}
+
+// Parse an ETag previously generated by Format
+func Parse(etag string) (uint32, error) {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	v, err := strconv.ParseUint(strings.Trim(etag, `"`), 10, 32)
+	return uint32(v), err
+}
+
+// FromIncomingContext returns the expected version sent using If-Match, 0 if none
+func FromIncomingContext(ctx context.Context) (uint32, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(MetadataKey)) == 0 {
+		return 0, nil
+	}
+
+	return Parse(md.Get(MetadataKey)[0])
+}
@@ -0,0 +1,50 @@
+package etag
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorHandler(t *testing.T) {
+	const reason = "VERSION_MISMATCH"
+
+	withReason := func(c codes.Code, reason string) error {
+		st, err := status.New(c, "failed").WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: "app"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return st.Err()
+	}
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "version mismatch", err: withReason(codes.FailedPrecondition, reason), wantStatus: http.StatusPreconditionFailed},
+		{name: "other failed precondition", err: withReason(codes.FailedPrecondition, "ACCOUNT_LOCKED"), wantStatus: http.StatusBadRequest},
+		{name: "failed precondition without a reason", err: status.Error(codes.FailedPrecondition, "failed"), wantStatus: http.StatusBadRequest},
+		{name: "other code with the reason", err: withReason(codes.Aborted, reason), wantStatus: http.StatusConflict},
+		{name: "not found", err: status.Error(codes.NotFound, "not found"), wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPatch, "/v1/visit/1", nil)
+
+			ErrorHandler(nil, reason)(context.Background(), runtime.NewServeMux(), &runtime.JSONPb{}, rec, r, tt.err)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata key that carries the HTTP X-Request-Id header
+const MetadataKey = "x-request-id"
+
+// Metadata forwards the X-Request-Id header (e.g set by a load balancer) as gRPC metadata, use with runtime.WithMetadata
+func Metadata(ctx context.Context, r *http.Request) metadata.MD {
+	if requestID := r.Header.Get("X-Request-Id"); requestID != "" {
+		return metadata.Pairs(MetadataKey, requestID)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package tenant
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata key that carries the HTTP X-Tenant-Id header
+const MetadataKey = "x-tenant-id"
+
+// Metadata forwards the X-Tenant-Id header as gRPC metadata, use with runtime.WithMetadata
+func Metadata(ctx context.Context, r *http.Request) metadata.MD {
+	if tenantID := r.Header.Get("X-Tenant-Id"); tenantID != "" {
+		return metadata.Pairs(MetadataKey, tenantID)
+	}
+
+	return nil
+}
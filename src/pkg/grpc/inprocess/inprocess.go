@@ -0,0 +1,139 @@
+package inprocess
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// AuthType of the connections dialed in-process
+const AuthType = "in-process"
+
+var errClosed = errors.New("in-process listener is closed")
+
+// Listener is an in-memory listener, for clients of the same process (e.g the HTTP gateway) to call a gRPC server
+// without going over the network. Each connection is a net.Pipe
+type Listener struct {
+	conns     chan net.Conn
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewListener creates a Listener, connections are dialed using DialContext
+func NewListener() *Listener {
+	return &Listener{conns: make(chan net.Conn), done: make(chan struct{})}
+}
+
+func (l *Listener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, errClosed
+	}
+}
+
+// Close stops accepting connections, connections that were already accepted are left open
+func (l *Listener) Close() error {
+	l.closeOnce.Do(func() { close(l.done) })
+	return nil
+}
+
+func (l *Listener) Addr() net.Addr {
+	return addr{}
+}
+
+// DialContext connects to the listener once it accepts, use with grpc.WithContextDialer
+func (l *Listener) DialContext(c context.Context, _ string) (net.Conn, error) {
+	server, client := net.Pipe()
+
+	select {
+	case l.conns <- &inProcessConn{Conn: server}:
+		return &inProcessConn{Conn: client}, nil
+	case <-l.done:
+		server.Close()
+		client.Close()
+		return nil, errClosed
+	case <-c.Done():
+		server.Close()
+		client.Close()
+		return nil, c.Err()
+	}
+}
+
+type addr struct{}
+
+func (addr) Network() string { return AuthType }
+func (addr) String() string  { return AuthType }
+
+type inProcessConn struct {
+	net.Conn
+}
+
+// AuthInfo is the peer credentials.AuthInfo of the in-process connections
+type AuthInfo struct {
+	credentials.CommonAuthInfo
+}
+
+func (AuthInfo) AuthType() string {
+	return AuthType
+}
+
+// IsInProcess reports whether the peer's connection was dialed in-process, so its metadata can be trusted
+func IsInProcess(info credentials.AuthInfo) bool {
+	_, ok := info.(AuthInfo)
+	return ok
+}
+
+// Credentials are the gRPC transport credentials of both the in-process client and the server.
+// In-process connections skip the handshake, there's no network to secure. Connections accepted by a tls.Listener
+// (see tls.NewListener) are handshaked and reported as credentials.TLSInfo, which holds the client's certificate.
+// Any other connection is accepted as is, clients can only dial in-process
+func Credentials() credentials.TransportCredentials {
+	return &transportCredentials{}
+}
+
+type transportCredentials struct{}
+
+func (tc *transportCredentials) ClientHandshake(c context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if _, ok := conn.(*inProcessConn); !ok {
+		return nil, nil, errors.New("in-process credentials can only dial in-process")
+	}
+
+	return conn, AuthInfo{CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity}}, nil
+}
+
+func (tc *transportCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	switch c := conn.(type) {
+	case *inProcessConn:
+		return conn, AuthInfo{CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity}}, nil
+	case *tls.Conn:
+		// Bound by the server's connection timeout
+		if err := c.Handshake(); err != nil {
+			return nil, nil, err
+		}
+
+		return conn, credentials.TLSInfo{
+			State:          c.ConnectionState(),
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		}, nil
+	}
+
+	return conn, nil, nil
+}
+
+func (tc *transportCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: AuthType}
+}
+
+func (tc *transportCredentials) Clone() credentials.TransportCredentials {
+	return &transportCredentials{}
+}
+
+func (tc *transportCredentials) OverrideServerName(string) error {
+	return nil
+}
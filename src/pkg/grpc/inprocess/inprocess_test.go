@@ -0,0 +1,70 @@
+package inprocess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+)
+
+func TestListener(t *testing.T) {
+	var peerInfo *peer.Peer
+	server := grpc.NewServer(
+		grpc.Creds(Credentials()),
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			peerInfo, _ = peer.FromContext(ctx)
+			return handler(ctx, req)
+		}),
+	)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+
+	lis := NewListener()
+	go server.Serve(lis)
+	defer server.Stop()
+
+	c, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(c, "in-process", grpc.WithTransportCredentials(Credentials()), grpc.WithContextDialer(lis.DialContext))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	res, err := healthpb.NewHealthClient(conn).Check(c, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("expected SERVING, got %s", res.GetStatus())
+	}
+	if peerInfo == nil || !IsInProcess(peerInfo.AuthInfo) {
+		t.Errorf("expected the peer to be in-process, got %+v", peerInfo)
+	}
+
+	// Closed, no connection is accepted anymore
+	lis.Close()
+	if _, err := lis.DialContext(c, ""); err != errClosed {
+		t.Errorf("expected %v, got %v", errClosed, err)
+	}
+	if _, err := lis.Accept(); err != errClosed {
+		t.Errorf("expected %v, got %v", errClosed, err)
+	}
+}
+
+func TestListener_DialCanceled(t *testing.T) {
+	// Nothing accepts
+	lis := NewListener()
+	defer lis.Close()
+
+	c, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := lis.DialContext(c, ""); err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
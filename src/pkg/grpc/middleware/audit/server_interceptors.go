@@ -0,0 +1,67 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/requestid"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/auth"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/jaeger-client-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys of the actor. Over HTTP the principal is sent as the Grpc-Metadata-X-Principal header,
+// the request ID as X-Request-Id (see grpc-gateway/requestid).
+// The principal authenticated by the auth interceptor takes precedence over the metadata
+const (
+	PrincipalMetadataKey = "x-principal"
+	RequestIDMetadataKey = requestid.MetadataKey
+)
+
+// UnaryServerInterceptor returns a new unary server interceptor that sets the actor recorded by the audit log.
+// Must be chained after the tracing and auth interceptors, to pick up the trace ID and the principal.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withActor(ctx), req)
+	}
+}
+
+// StreamServerInterceptor returns a new streaming server interceptor that sets the actor recorded by the audit log.
+// Must be chained after the tracing and auth interceptors, to pick up the trace ID and the principal.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = withActor(stream.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+func withActor(ctx context.Context) context.Context {
+	actor := app.Actor{}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		actor.Principal = first(md.Get(PrincipalMetadataKey))
+		actor.RequestID = first(md.Get(RequestIDMetadataKey))
+	}
+	if principal, ok := auth.FromContext(ctx); ok {
+		actor.Principal = principal.Subject
+	}
+
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		if spanCtx, ok := span.Context().(jaeger.SpanContext); ok {
+			actor.TraceID = spanCtx.TraceID().String()
+		}
+	}
+
+	return app.WithActor(ctx, actor)
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
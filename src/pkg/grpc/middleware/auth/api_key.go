@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/apikey"
+	"google.golang.org/grpc/metadata"
+)
+
+// APIKeyMetadataKey carries the API key. Over HTTP it's sent as the X-Api-Key header
+const APIKeyMetadataKey = apikey.MetadataKey
+
+// NewAPIKeyAuthenticator creates an Authenticator of the API keys sent as x-api-key metadata,
+// verify returns the principal of a key
+func NewAPIKeyAuthenticator(verify func(ctx context.Context, key string) (*Principal, error)) Authenticator {
+	return &apiKeyAuthenticator{verify: verify}
+}
+
+type apiKeyAuthenticator struct {
+	verify func(ctx context.Context, key string) (*Principal, error)
+}
+
+func (a *apiKeyAuthenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(APIKeyMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return nil, ErrNoCredentials
+	}
+
+	return a.verify(ctx, values[0])
+}
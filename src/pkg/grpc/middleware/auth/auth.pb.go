@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0-devel
+// 	protoc        v3.14.0
+// source: src/pkg/grpc/middleware/auth/auth.proto
+
+package auth
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Rules of a method, enforced by the auth interceptors
+type Rules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Skip authentication, e.g to log in
+	Public bool `protobuf:"varint,1,opt,name=Public,proto3" json:"Public,omitempty"`
+	// Scopes the caller must have, all of them
+	Scopes []string `protobuf:"bytes,2,rep,name=Scopes,proto3" json:"Scopes,omitempty"`
+	// Roles allowed to call the method, any of them
+	Roles []string `protobuf:"bytes,3,rep,name=Roles,proto3" json:"Roles,omitempty"`
+}
+
+func (x *Rules) Reset() {
+	*x = Rules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_pkg_grpc_middleware_auth_auth_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rules) ProtoMessage() {}
+
+func (x *Rules) ProtoReflect() protoreflect.Message {
+	mi := &file_src_pkg_grpc_middleware_auth_auth_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rules.ProtoReflect.Descriptor instead.
+func (*Rules) Descriptor() ([]byte, []int) {
+	return file_src_pkg_grpc_middleware_auth_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Rules) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *Rules) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *Rules) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+var file_src_pkg_grpc_middleware_auth_auth_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*Rules)(nil),
+		Field:         51000,
+		Name:          "auth.rules",
+		Tag:           "bytes,51000,opt,name=rules",
+		Filename:      "src/pkg/grpc/middleware/auth/auth.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional auth.Rules rules = 51000;
+	E_Rules = &file_src_pkg_grpc_middleware_auth_auth_proto_extTypes[0]
+)
+
+var File_src_pkg_grpc_middleware_auth_auth_proto protoreflect.FileDescriptor
+
+var file_src_pkg_grpc_middleware_auth_auth_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x73, 0x72, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a,
+	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x4d, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x3a, 0x43, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb8, 0x8e, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x64, 0x61, 0x64, 0x38, 0x37, 0x2f, 0x67, 0x6f, 0x2d, 0x62,
+	0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
+	0x72, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_src_pkg_grpc_middleware_auth_auth_proto_rawDescOnce sync.Once
+	file_src_pkg_grpc_middleware_auth_auth_proto_rawDescData = file_src_pkg_grpc_middleware_auth_auth_proto_rawDesc
+)
+
+func file_src_pkg_grpc_middleware_auth_auth_proto_rawDescGZIP() []byte {
+	file_src_pkg_grpc_middleware_auth_auth_proto_rawDescOnce.Do(func() {
+		file_src_pkg_grpc_middleware_auth_auth_proto_rawDescData = protoimpl.X.CompressGZIP(file_src_pkg_grpc_middleware_auth_auth_proto_rawDescData)
+	})
+	return file_src_pkg_grpc_middleware_auth_auth_proto_rawDescData
+}
+
+var file_src_pkg_grpc_middleware_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_src_pkg_grpc_middleware_auth_auth_proto_goTypes = []interface{}{
+	(*Rules)(nil),                      // 0: auth.Rules
+	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+}
+var file_src_pkg_grpc_middleware_auth_auth_proto_depIdxs = []int32{
+	1, // 0: auth.rules:extendee -> google.protobuf.MethodOptions
+	0, // 1: auth.rules:type_name -> auth.Rules
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_src_pkg_grpc_middleware_auth_auth_proto_init() }
+func file_src_pkg_grpc_middleware_auth_auth_proto_init() {
+	if File_src_pkg_grpc_middleware_auth_auth_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_src_pkg_grpc_middleware_auth_auth_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_src_pkg_grpc_middleware_auth_auth_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_src_pkg_grpc_middleware_auth_auth_proto_goTypes,
+		DependencyIndexes: file_src_pkg_grpc_middleware_auth_auth_proto_depIdxs,
+		MessageInfos:      file_src_pkg_grpc_middleware_auth_auth_proto_msgTypes,
+		ExtensionInfos:    file_src_pkg_grpc_middleware_auth_auth_proto_extTypes,
+	}.Build()
+	File_src_pkg_grpc_middleware_auth_auth_proto = out.File
+	file_src_pkg_grpc_middleware_auth_auth_proto_rawDesc = nil
+	file_src_pkg_grpc_middleware_auth_auth_proto_goTypes = nil
+	file_src_pkg_grpc_middleware_auth_auth_proto_depIdxs = nil
+}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/clientcert"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc/inprocess"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientCertMetadataKey carries the client certificate of HTTP requests, forwarded by the in-process gateway
+const ClientCertMetadataKey = clientcert.MetadataKey
+
+// ClientCertFromContext returns the verified client certificate of the request, sent over mutual TLS.
+// For requests of the in-process gateway, it's the certificate of the HTTP request
+func ClientCertFromContext(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	switch info := p.AuthInfo.(type) {
+	case credentials.TLSInfo:
+		if len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+			return nil, false
+		}
+		return info.State.VerifiedChains[0][0], true
+	case inprocess.AuthInfo:
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(ClientCertMetadataKey)
+		if len(values) == 0 {
+			return nil, false
+		}
+
+		der, err := base64.StdEncoding.DecodeString(values[0])
+		if err != nil {
+			return nil, false
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, false
+		}
+		return cert, true
+	}
+
+	return nil, false
+}
+
+// NewClientCertAuthenticator creates an Authenticator of the client certificates verified by mutual TLS.
+// The subject is the certificate's common name, its organizational units are the roles, and all certificates are
+// granted scopes
+func NewClientCertAuthenticator(scopes []string) Authenticator {
+	return &clientCertAuthenticator{scopes: scopes}
+}
+
+type clientCertAuthenticator struct {
+	scopes []string
+}
+
+func (a *clientCertAuthenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	cert, ok := ClientCertFromContext(ctx)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+
+	return &Principal{
+		Subject: "cert:" + cert.Subject.CommonName,
+		Scopes:  a.scopes,
+		Roles:   cert.Subject.OrganizationalUnit,
+		Claims: map[string]interface{}{
+			"subject":   cert.Subject.String(),
+			"issuer":    cert.Issuer.String(),
+			"serial":    cert.SerialNumber.String(),
+			"dns_names": cert.DNSNames,
+			"emails":    cert.EmailAddresses,
+		},
+	}, nil
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc/inprocess"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// newClientCert generates a self-signed client certificate
+func newClientCert(t *testing.T, commonName string, units ...string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName, OrganizationalUnit: units},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert
+}
+
+func TestClientCertAuthenticator_Authenticate(t *testing.T) {
+	cert := newClientCert(t, "billing", "editor", "auditor")
+	forwarded := metadata.Pairs(ClientCertMetadataKey, base64.StdEncoding.EncodeToString(cert.Raw))
+	verified := credentials.TLSInfo{State: tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{cert},
+		VerifiedChains:   [][]*x509.Certificate{{cert}},
+	}}
+
+	tests := []struct {
+		name     string
+		authInfo credentials.AuthInfo
+		noPeer   bool
+		md       metadata.MD
+		wantErr  error
+	}{
+		{name: "verified by mutual TLS", authInfo: verified},
+		{name: "sent but not verified", authInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}}, wantErr: ErrNoCredentials},
+		{name: "TLS without a certificate", authInfo: credentials.TLSInfo{}, wantErr: ErrNoCredentials},
+		{name: "forwarded by the in-process gateway", authInfo: inprocess.AuthInfo{}, md: forwarded},
+		{name: "in-process without a certificate", authInfo: inprocess.AuthInfo{}, wantErr: ErrNoCredentials},
+		{name: "in-process, not base64", authInfo: inprocess.AuthInfo{}, md: metadata.Pairs(ClientCertMetadataKey, "!!"), wantErr: ErrNoCredentials},
+		{name: "in-process, not a certificate", authInfo: inprocess.AuthInfo{}, md: metadata.Pairs(ClientCertMetadataKey, "Y2VydA=="), wantErr: ErrNoCredentials},
+		// Only the gateway's metadata is trusted, any other client could claim any certificate
+		{name: "forwarded over TLS", authInfo: credentials.TLSInfo{}, md: forwarded, wantErr: ErrNoCredentials},
+		{name: "forwarded over an insecure connection", md: forwarded, wantErr: ErrNoCredentials},
+		{name: "forwarded without a peer", noPeer: true, md: forwarded, wantErr: ErrNoCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if !tt.noPeer {
+				ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: tt.authInfo})
+			}
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			p, err := NewClientCertAuthenticator([]string{"visit.read"}).Authenticate(ctx)
+			if err != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if p.Subject != "cert:billing" || !reflect.DeepEqual(p.Roles, []string{"editor", "auditor"}) || !reflect.DeepEqual(p.Scopes, []string{"visit.read"}) {
+				t.Errorf("unexpected principal %+v", p)
+			}
+			if p.Claims["serial"] != "1" {
+				t.Errorf("expected serial 1, got %v", p.Claims["serial"])
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KeySet holds the RSA public keys of a JWKS by key ID, loaded from a local file or a URL
+type KeySet struct {
+	source string
+	client *http.Client
+
+	mu   sync.RWMutex
+	keys map[string]*rsa.PublicKey
+}
+
+type jwks struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+// NewKeySet loads the JWKS at source, a file path or an http(s) URL
+func NewKeySet(c context.Context, source string) (*KeySet, error) {
+	ks := &KeySet{source: source, client: &http.Client{Timeout: 10 * time.Second}}
+	if err := ks.Refresh(c); err != nil {
+		return nil, err
+	}
+
+	return ks, nil
+}
+
+// Key returns the key of kid. Tokens without a key ID match the only key of the set, if there is a single one
+func (ks *KeySet) Key(kid string) (*rsa.PublicKey, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if kid == "" && len(ks.keys) == 1 {
+		for _, key := range ks.keys {
+			return key, true
+		}
+	}
+
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+// Refresh reloads the keys, the current keys are kept if it fails
+func (ks *KeySet) Refresh(c context.Context) error {
+	b, err := ks.read(c)
+	if err != nil {
+		return err
+	}
+
+	set := &jwks{}
+	if err := json.Unmarshal(b, set); err != nil {
+		return err
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, k := range set.Keys {
+		// Only RSA signing keys are supported
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+
+		key, err := rsaPublicKey(k.N, k.E)
+		if err != nil {
+			return fmt.Errorf("invalid key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return errors.New("JWKS has no RSA signing keys")
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.mu.Unlock()
+
+	return nil
+}
+
+// RefreshEvery reloads the keys every interval until c is done, e.g to pick up rotated keys. Failures are passed to onError
+func (ks *KeySet) RefreshEvery(c context.Context, interval time.Duration, onError func(err error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := ks.Refresh(c); err != nil {
+			onError(err)
+		}
+	}
+}
+
+func (ks *KeySet) read(c context.Context) ([]byte, error) {
+	if !strings.HasPrefix(ks.source, "http://") && !strings.HasPrefix(ks.source, "https://") {
+		return ioutil.ReadFile(ks.source)
+	}
+
+	req, err := http.NewRequestWithContext(c, http.MethodGet, ks.source, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ks.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS request failed: %s", res.Status)
+	}
+
+	return ioutil.ReadAll(res.Body)
+}
+
+func rsaPublicKey(n string, e string) (*rsa.PublicKey, error) {
+	nb, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, err
+	}
+
+	eb, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, err
+	}
+
+	exp := new(big.Int).SetBytes(eb)
+	if !exp.IsInt64() || exp.Int64() > 1<<31-1 {
+		return nil, errors.New("exponent is too large")
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(nb), E: int(exp.Int64())}, nil
+}
@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/metadata"
+)
+
+// ErrNoCredentials is returned by an Authenticator when the request carries no credentials it handles
+var ErrNoCredentials = errors.New("no credentials")
+
+// ErrInvalidCredentials matches the errors of credentials that are rejected, see InvalidCredentials
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Authenticator verifies the credentials of a request
+type Authenticator interface {
+	// Authenticate returns the principal of the request, ErrNoCredentials if it carries none.
+	// Rejected credentials are reported using InvalidCredentials, any other error is a failure to verify them (e.g a store is down)
+	Authenticate(ctx context.Context) (*Principal, error)
+}
+
+// InvalidCredentials returns an error that matches ErrInvalidCredentials, reason is sent to the client
+func InvalidCredentials(reason string) error {
+	return &credentialsError{reason: reason}
+}
+
+type credentialsError struct {
+	reason string
+}
+
+func (e *credentialsError) Error() string {
+	return e.reason
+}
+
+func (e *credentialsError) Is(target error) bool {
+	return target == ErrInvalidCredentials
+}
+
+// JWTConfig sets the accepted tokens, at least one of Secret and Keys is required
+type JWTConfig struct {
+	Secret   []byte  // HS256 key, empty to reject HS256 tokens
+	Keys     *KeySet // RS256 keys, nil to reject RS256 tokens
+	Issuer   string  // Expected iss claim, optional
+	Audience string  // Expected aud claim, optional
+}
+
+// NewJWTAuthenticator creates an Authenticator of bearer JWTs, sent as the authorization metadata. Tokens must have an exp claim.
+// Scopes are read from the space separated scope claim, or the scp array claim. Roles from the roles array claim,
+// and the tenant the token is bound to from the tid claim
+func NewJWTAuthenticator(conf JWTConfig) *JWTAuthenticator {
+	var methods []string
+	if len(conf.Secret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if conf.Keys != nil {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	return &JWTAuthenticator{conf: conf, parser: &jwt.Parser{ValidMethods: methods}}
+}
+
+type JWTAuthenticator struct {
+	conf   JWTConfig
+	parser *jwt.Parser
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	raw, ok := bearerToken(ctx)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := a.parser.ParseWithClaims(raw, claims, a.key); err != nil {
+		return nil, InvalidCredentials(err.Error())
+	}
+
+	// The parser only checks exp if it's set, a token without one would never expire
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, InvalidCredentials("token has no expiration")
+	}
+
+	if a.conf.Issuer != "" && !claims.VerifyIssuer(a.conf.Issuer, true) {
+		return nil, InvalidCredentials("unexpected token issuer")
+	}
+	if a.conf.Audience != "" && !claims.VerifyAudience(a.conf.Audience, true) {
+		return nil, InvalidCredentials("unexpected token audience")
+	}
+
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return nil, InvalidCredentials("token has no subject")
+	}
+
+	principal := &Principal{Subject: sub, Scopes: scopes(claims), Roles: stringArray(claims["roles"]), Claims: claims}
+	if tenantID, ok := claims["tid"].(string); ok {
+		principal.TenantID = &tenantID
+	}
+
+	return principal, nil
+}
+
+// key returns the verification key of a token, its method was already checked against the parser's ValidMethods
+func (a *JWTAuthenticator) key(token *jwt.Token) (interface{}, error) {
+	if token.Method.Alg() == jwt.SigningMethodHS256.Alg() {
+		return a.conf.Secret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := a.conf.Keys.Key(kid)
+	if !ok {
+		return nil, errors.New("unknown token key ID")
+	}
+
+	return key, nil
+}
+
+// bearerToken returns the token of the "authorization: Bearer <token>" metadata
+func bearerToken(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+
+	const prefix = "bearer "
+	if len(values[0]) <= len(prefix) || !strings.EqualFold(values[0][:len(prefix)], prefix) {
+		return "", false
+	}
+
+	return strings.TrimSpace(values[0][len(prefix):]), true
+}
+
+func scopes(claims jwt.MapClaims) []string {
+	if scope, ok := claims["scope"].(string); ok {
+		return strings.Fields(scope)
+	}
+
+	return stringArray(claims["scp"])
+}
+
+// stringArray returns the strings of a JSON array claim
+func stringArray(claim interface{}) []string {
+	values, _ := claim.([]interface{})
+
+	var s []string
+	for _, value := range values {
+		if value, ok := value.(string); ok {
+			s = append(s, value)
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestJWTAuthenticator_Authenticate(t *testing.T) {
+	secret := []byte("secret")
+	exp := time.Now().Add(time.Minute).Unix()
+
+	tests := []struct {
+		name          string
+		authorization string
+		claims        jwt.MapClaims
+		secret        []byte
+		wantErr       bool
+		wantSubject   string
+	}{
+		{name: "valid", claims: jwt.MapClaims{"sub": "john", "exp": exp}, wantSubject: "john"},
+		{name: "no credentials", authorization: "-", wantErr: true},
+		{name: "not a bearer token", authorization: "Basic am9objpzZWNyZXQ=", wantErr: true},
+		{name: "no expiration", claims: jwt.MapClaims{"sub": "john"}, wantErr: true},
+		{name: "expired", claims: jwt.MapClaims{"sub": "john", "exp": time.Now().Add(-time.Minute).Unix()}, wantErr: true},
+		{name: "no subject", claims: jwt.MapClaims{"exp": exp}, wantErr: true},
+		{name: "wrong issuer", claims: jwt.MapClaims{"sub": "john", "exp": exp, "iss": "other"}, wantErr: true},
+		{name: "wrong secret", claims: jwt.MapClaims{"sub": "john", "exp": exp}, secret: []byte("other"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewJWTAuthenticator(JWTConfig{Secret: secret, Issuer: "boilerplate"})
+
+			authorization := tt.authorization
+			if authorization == "" {
+				if _, ok := tt.claims["iss"]; !ok {
+					tt.claims["iss"] = "boilerplate"
+				}
+				key := secret
+				if tt.secret != nil {
+					key = tt.secret
+				}
+
+				token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, tt.claims).SignedString(key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				authorization = "Bearer " + token
+			}
+
+			md := metadata.Pairs()
+			if authorization != "-" {
+				md.Set("authorization", authorization)
+			}
+			p, err := a.Authenticate(metadata.NewIncomingContext(context.Background(), md))
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			// Requests without a bearer token are left to the other authenticators, rejected tokens aren't
+			if noCredentials := err == ErrNoCredentials; noCredentials != (tt.authorization != "") {
+				t.Fatalf("expected ErrNoCredentials %v, got %v", tt.authorization != "", err)
+			}
+			if err != nil && err != ErrNoCredentials && !errors.Is(err, ErrInvalidCredentials) {
+				t.Fatalf("expected a rejected token to be invalid credentials, got %v", err)
+			}
+			if err == nil && p.Subject != tt.wantSubject {
+				t.Errorf("expected subject %s, got %s", tt.wantSubject, p.Subject)
+			}
+		})
+	}
+}
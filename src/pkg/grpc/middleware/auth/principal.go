@@ -0,0 +1,49 @@
+package auth
+
+import "context"
+
+// Principal is the authenticated caller
+type Principal struct {
+	Subject string
+	Scopes  []string
+	Roles   []string
+	// TenantID the credentials belong to, nil if they aren't bound to one. Enforced by the tenant interceptor
+	TenantID *string
+	// Claims of the credentials, e.g a JWT's
+	Claims map[string]interface{}
+}
+
+// HasScope reports whether the principal was granted scope
+func (p *Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasRole reports whether the principal was given role
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+type principalKey struct{}
+
+// NewContext returns a new context that carries p
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal authenticated by the interceptors, if any
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// loadRules returns the rules declared by the (auth.rules) option of every registered method,
+// keyed by the full method name (e.g /pb.Visit/Get). Methods without rules are left out
+func loadRules() map[string]*Rules {
+	rules := map[string]*Rules{}
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				if method.Options() == nil || !proto.HasExtension(method.Options(), E_Rules) {
+					continue
+				}
+
+				fullMethod := fmt.Sprintf("/%s/%s", services.Get(i).FullName(), method.Name())
+				rules[fullMethod] = proto.GetExtension(method.Options(), E_Rules).(*Rules)
+			}
+		}
+		return true
+	})
+
+	return rules
+}
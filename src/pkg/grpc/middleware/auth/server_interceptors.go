@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain and reasons of the errdetails.ErrorInfo attached to PERMISSION_DENIED errors
+const (
+	ErrorDomain = "auth"
+
+	ReasonMissingScope       = "MISSING_SCOPE"
+	ReasonMissingRole        = "MISSING_ROLE"
+	ReasonMethodNotAnnotated = "METHOD_NOT_ANNOTATED"
+)
+
+// UnaryServerInterceptor returns a new unary server interceptor that authenticates requests and enforces
+// the scopes and roles of the (auth.rules) method option. Authenticators are tried in order, until one finds its credentials.
+// Methods marked as public are not authenticated, methods without rules are denied if strict is set.
+// Without authenticators, authentication is disabled unless strict is set, then only public methods are allowed.
+func UnaryServerInterceptor(strict bool, authenticators ...Authenticator) grpc.UnaryServerInterceptor {
+	rules := loadRules()
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorize(ctx, info.FullMethod, rules, strict, authenticators)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a new streaming server interceptor that authenticates requests and enforces
+// the scopes and roles of the (auth.rules) method option. Authenticators are tried in order, until one finds its credentials.
+// Methods marked as public are not authenticated, methods without rules are denied if strict is set.
+// Without authenticators, authentication is disabled unless strict is set, then only public methods are allowed.
+func StreamServerInterceptor(strict bool, authenticators ...Authenticator) grpc.StreamServerInterceptor {
+	rules := loadRules()
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(stream.Context(), info.FullMethod, rules, strict, authenticators)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+func authorize(ctx context.Context, fullMethod string, rules map[string]*Rules, strict bool, authenticators []Authenticator) (context.Context, error) {
+	// Strict mode is checked first, it never lets a request through unauthenticated, even without authenticators
+	methodRules, ok := rules[fullMethod]
+	if !ok && strict {
+		return nil, permissionDenied(ReasonMethodNotAnnotated, fullMethod+" has no auth rules", map[string]string{"method": fullMethod})
+	}
+	if methodRules.GetPublic() || (len(authenticators) == 0 && !strict) {
+		return ctx, nil
+	}
+
+	principal, err := authenticate(ctx, authenticators)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scope := range methodRules.GetScopes() {
+		if !principal.HasScope(scope) {
+			return nil, permissionDenied(ReasonMissingScope, "missing scope "+scope, map[string]string{"method": fullMethod, "scope": scope})
+		}
+	}
+
+	if roles := methodRules.GetRoles(); len(roles) > 0 && !hasAnyRole(principal, roles) {
+		return nil, permissionDenied(ReasonMissingRole, "requires one of the roles "+strings.Join(roles, ", "),
+			map[string]string{"method": fullMethod, "roles": strings.Join(roles, ",")})
+	}
+
+	return NewContext(ctx, principal), nil
+}
+
+func authenticate(ctx context.Context, authenticators []Authenticator) (*Principal, error) {
+	for _, a := range authenticators {
+		principal, err := a.Authenticate(ctx)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		if errors.Is(err, ErrInvalidCredentials) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		// The credentials couldn't be verified, e.g a store is down. Its details are internal, they're logged along with the request
+		if err != nil {
+			grpc_ctxtags.Extract(ctx).Set("auth.error", err.Error())
+			return nil, status.Error(codes.Unavailable, "credentials can't be verified")
+		}
+
+		return principal, nil
+	}
+
+	return nil, status.Error(codes.Unauthenticated, "missing credentials")
+}
+
+func hasAnyRole(p *Principal, roles []string) bool {
+	for _, role := range roles {
+		if p.HasRole(role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func permissionDenied(reason string, message string, metadata map[string]string) error {
+	st := status.New(codes.PermissionDenied, message)
+	det, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return det.Err()
+}
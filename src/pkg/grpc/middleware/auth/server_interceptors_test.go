@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authenticatorFunc adapts a function to an Authenticator
+type authenticatorFunc func(ctx context.Context) (*Principal, error)
+
+func (f authenticatorFunc) Authenticate(ctx context.Context) (*Principal, error) {
+	return f(ctx)
+}
+
+func authenticated(p *Principal) Authenticator {
+	return authenticatorFunc(func(ctx context.Context) (*Principal, error) { return p, nil })
+}
+
+var noCredentials = authenticatorFunc(func(ctx context.Context) (*Principal, error) { return nil, ErrNoCredentials })
+
+func TestAuthorize(t *testing.T) {
+	rules := map[string]*Rules{
+		"/Public":   {Public: true},
+		"/Read":     {Scopes: []string{"visit.read"}},
+		"/Write":    {Scopes: []string{"visit.read", "visit.write"}, Roles: []string{"editor", "admin"}},
+		"/Unscoped": {},
+	}
+	reader := &Principal{Subject: "reader", Scopes: []string{"visit.read"}}
+	editor := &Principal{Subject: "editor", Scopes: []string{"visit.read", "visit.write"}, Roles: []string{"editor"}}
+
+	tests := []struct {
+		name           string
+		method         string
+		strict         bool
+		authenticators []Authenticator
+		wantCode       codes.Code
+		wantReason     string
+		wantMessage    string
+		wantPrincipal  *Principal
+	}{
+		{name: "disabled", method: "/Write", wantCode: codes.OK},
+		{name: "disabled, not annotated", method: "/Unknown", wantCode: codes.OK},
+		{name: "strict without authenticators, not annotated", method: "/Unknown", strict: true,
+			wantCode: codes.PermissionDenied, wantReason: ReasonMethodNotAnnotated},
+		{name: "strict without authenticators", method: "/Read", strict: true, wantCode: codes.Unauthenticated},
+		{name: "strict without authenticators, public", method: "/Public", strict: true, wantCode: codes.OK},
+		{name: "not annotated", method: "/Unknown", authenticators: []Authenticator{authenticated(editor)}, wantCode: codes.OK, wantPrincipal: editor},
+		{name: "strict, not annotated", method: "/Unknown", strict: true, authenticators: []Authenticator{authenticated(editor)},
+			wantCode: codes.PermissionDenied, wantReason: ReasonMethodNotAnnotated},
+		{name: "public", method: "/Public", strict: true, authenticators: []Authenticator{noCredentials}, wantCode: codes.OK},
+		{name: "missing credentials", method: "/Read", authenticators: []Authenticator{noCredentials}, wantCode: codes.Unauthenticated},
+		{name: "invalid credentials", method: "/Read", wantCode: codes.Unauthenticated, wantMessage: "expired", authenticators: []Authenticator{
+			authenticatorFunc(func(ctx context.Context) (*Principal, error) { return nil, InvalidCredentials("expired") }),
+			authenticated(editor),
+		}},
+		{name: "verification failed", method: "/Read", wantCode: codes.Unavailable, wantMessage: "credentials can't be verified", authenticators: []Authenticator{
+			authenticatorFunc(func(ctx context.Context) (*Principal, error) {
+				return nil, errors.New("dial tcp 10.0.0.1:3306: connection refused")
+			}),
+			authenticated(editor),
+		}},
+		{name: "next authenticator", method: "/Read", authenticators: []Authenticator{noCredentials, authenticated(reader)},
+			wantCode: codes.OK, wantPrincipal: reader},
+		{name: "scope", method: "/Read", authenticators: []Authenticator{authenticated(reader)}, wantCode: codes.OK, wantPrincipal: reader},
+		{name: "missing scope", method: "/Write", authenticators: []Authenticator{authenticated(reader)},
+			wantCode: codes.PermissionDenied, wantReason: ReasonMissingScope},
+		{name: "missing role", method: "/Write", authenticators: []Authenticator{authenticated(&Principal{Scopes: editor.Scopes, Roles: []string{"viewer"}})},
+			wantCode: codes.PermissionDenied, wantReason: ReasonMissingRole},
+		{name: "scopes and role", method: "/Write", strict: true, authenticators: []Authenticator{authenticated(editor)},
+			wantCode: codes.OK, wantPrincipal: editor},
+		{name: "no rules", method: "/Unscoped", strict: true, authenticators: []Authenticator{authenticated(reader)},
+			wantCode: codes.OK, wantPrincipal: reader},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := authorize(context.Background(), tt.method, rules, tt.strict, tt.authenticators)
+
+			st := status.Convert(err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("expected %s, got %v", tt.wantCode, err)
+			}
+			if tt.wantMessage != "" && st.Message() != tt.wantMessage {
+				t.Errorf("expected message %q, got %q", tt.wantMessage, st.Message())
+			}
+			if tt.wantReason != "" {
+				var reason string
+				for _, detail := range st.Details() {
+					if info, ok := detail.(*errdetails.ErrorInfo); ok {
+						reason = info.GetReason()
+					}
+				}
+				if reason != tt.wantReason {
+					t.Errorf("expected reason %s, got %q", tt.wantReason, reason)
+				}
+			}
+			if err != nil {
+				return
+			}
+
+			p, _ := FromContext(ctx)
+			if p != tt.wantPrincipal {
+				t.Errorf("expected principal %+v, got %+v", tt.wantPrincipal, p)
+			}
+		})
+	}
+}
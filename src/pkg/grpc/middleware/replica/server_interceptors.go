@@ -0,0 +1,45 @@
+package replica
+
+import (
+	"context"
+
+	pkgReplica "github.com/eldad87/go-boilerplate/src/pkg/replica"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey marks a read-your-writes session, reads are served by the primary.
+// Over HTTP it's sent as the Grpc-Metadata-X-Read-Your-Writes header
+const MetadataKey = "x-read-your-writes"
+
+// UnaryServerInterceptor returns a new unary server interceptor that routes read-your-writes sessions to the primary.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withPrimary(ctx), req)
+	}
+}
+
+// StreamServerInterceptor returns a new streaming server interceptor that routes read-your-writes sessions to the primary.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = withPrimary(stream.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+func withPrimary(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	for _, v := range md.Get(MetadataKey) {
+		if v == "true" || v == "1" {
+			return pkgReplica.WithPrimary(ctx)
+		}
+	}
+
+	return ctx
+}
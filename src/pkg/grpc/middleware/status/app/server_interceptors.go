@@ -0,0 +1,89 @@
+package app_error
+
+import (
+	"context"
+	"errors"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	grpcErrors "github.com/eldad87/go-boilerplate/src/pkg/grpc/error"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain of the errdetails.ErrorInfo attached to errors that have a reason, e.g app.ReasonVersionMismatch
+const ErrorDomain = "app"
+
+// UnaryServerInterceptor returns a new unary server interceptor that transform app errors to gRPC status code.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, ErrorHandler(err)
+		}
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns a new streaming server interceptor that transform app errors to gRPC status code.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, stream)
+		return ErrorHandler(err)
+	}
+}
+
+func ErrorHandler(error error) error {
+	// Query timeout, see database.query_timeout
+	if errors.Is(error, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, error.Error())
+	}
+
+	var appErr *app.Error
+	if !errors.As(error, &appErr) {
+		return error
+	}
+
+	c := codes.Unknown
+	switch appErr.Code {
+	case app.ErrCodeNotFound:
+		c = codes.NotFound
+	case app.ErrCodeConflict:
+		c = codes.AlreadyExists
+	case app.ErrCodeInvalid:
+		// Reuse the same details as any other validation error
+		br := grpcErrors.NewBadRequest()
+		br.AddViolation(appErr.Field, appErr.Message)
+		return br.GetStatusError(codes.InvalidArgument, appErr.Message)
+	case app.ErrCodePermissionDenied:
+		c = codes.PermissionDenied
+	case app.ErrCodeAborted:
+		c = codes.Aborted
+	case app.ErrCodeFailedPrecondition:
+		c = codes.FailedPrecondition
+	case app.ErrCodeUnauthenticated:
+		c = codes.Unauthenticated
+	case app.ErrCodeRateLimited:
+		c = codes.ResourceExhausted
+	}
+
+	details := []proto.Message{&errdetails.ResourceInfo{
+		ResourceType: appErr.ResourceType,
+		ResourceName: appErr.ResourceName,
+		Description:  appErr.Message,
+	}}
+	if appErr.Reason != "" {
+		details = append(details, &errdetails.ErrorInfo{Reason: appErr.Reason, Domain: ErrorDomain})
+	}
+
+	st := status.New(c, appErr.Message)
+	det, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return det.Err()
+}
@@ -0,0 +1,83 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	gatewayTenant "github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/tenant"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/auth"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataKey carries the tenant ID. Over HTTP it's sent as the X-Tenant-Id header
+const MetadataKey = gatewayTenant.MetadataKey
+
+// UnaryServerInterceptor returns a new unary server interceptor that sets the tenant requests act on behalf of.
+// Requests without a tenant use the default one (empty), unless required is set; then they fail with INVALID_ARGUMENT,
+// and authenticated requests fail with PERMISSION_DENIED unless their credentials are bound to a tenant.
+// Must be chained after the auth interceptor, credentials bound to a tenant set it.
+func UnaryServerInterceptor(required bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := withTenant(ctx, required)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a new streaming server interceptor that sets the tenant requests act on behalf of.
+// Requests without a tenant use the default one (empty), unless required is set; then they fail with INVALID_ARGUMENT,
+// and authenticated requests fail with PERMISSION_DENIED unless their credentials are bound to a tenant.
+// Must be chained after the auth interceptor, credentials bound to a tenant set it.
+func StreamServerInterceptor(required bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withTenant(stream.Context(), required)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+func withTenant(ctx context.Context, required bool) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var tenantID string
+	if values := md.Get(MetadataKey); len(values) > 0 {
+		tenantID = values[0]
+	}
+
+	principal, authenticated := auth.FromContext(ctx)
+	if authenticated {
+		// When tenants are required, credentials must be bound to one. Otherwise they'd act on behalf of any tenant they name
+		if required && (principal.TenantID == nil || *principal.TenantID == "") {
+			return nil, status.Error(codes.PermissionDenied, "credentials aren't bound to a tenant")
+		}
+
+		// Credentials bound to a tenant can't act on behalf of another
+		if principal.TenantID != nil {
+			if tenantID != "" && tenantID != *principal.TenantID {
+				return nil, status.Error(codes.PermissionDenied, "credentials don't belong to tenant "+tenantID)
+			}
+			return app.WithTenant(ctx, *principal.TenantID), nil
+		}
+	}
+
+	if tenantID != "" {
+		return app.WithTenant(ctx, tenantID), nil
+	}
+
+	if required {
+		return nil, status.Error(codes.InvalidArgument, "missing "+MetadataKey+" metadata")
+	}
+
+	return app.WithTenant(ctx, ""), nil
+}
@@ -0,0 +1,65 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithTenant(t *testing.T) {
+	acme, empty := "acme", ""
+
+	tests := []struct {
+		name       string
+		required   bool
+		header     string
+		principal  *auth.Principal
+		wantCode   codes.Code
+		wantTenant string
+	}{
+		{name: "default tenant", wantCode: codes.OK, wantTenant: ""},
+		{name: "header", header: "acme", wantCode: codes.OK, wantTenant: "acme"},
+		{name: "required", required: true, wantCode: codes.InvalidArgument},
+		{name: "required, header", required: true, header: "acme", wantCode: codes.OK, wantTenant: "acme"},
+		{name: "bound", principal: &auth.Principal{TenantID: &acme}, wantCode: codes.OK, wantTenant: "acme"},
+		{name: "bound, same header", principal: &auth.Principal{TenantID: &acme}, header: "acme", wantCode: codes.OK, wantTenant: "acme"},
+		{name: "bound, other header", principal: &auth.Principal{TenantID: &acme}, header: "globex", wantCode: codes.PermissionDenied},
+		{name: "not bound", principal: &auth.Principal{}, header: "globex", wantCode: codes.OK, wantTenant: "globex"},
+		{name: "required, bound", required: true, principal: &auth.Principal{TenantID: &acme}, wantCode: codes.OK, wantTenant: "acme"},
+		{name: "required, not bound", required: true, principal: &auth.Principal{}, header: "acme", wantCode: codes.PermissionDenied},
+		{name: "required, bound to the default tenant", required: true, principal: &auth.Principal{TenantID: &empty}, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, tt.header))
+			}
+			if tt.principal != nil {
+				ctx = auth.NewContext(ctx, tt.principal)
+			}
+
+			ctx, err := withTenant(ctx, tt.required)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("expected %s, got %v", tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+
+			tenantID, err := app.TenantFromContext(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tenantID != tt.wantTenant {
+				t.Errorf("expected tenant %q, got %q", tt.wantTenant, tenantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package healthcheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/heptiolabs/healthcheck"
+)
+
+// WithNonFatalChecks decorates h with non-fatal readiness checks. They're reported along with the other checks,
+// in the full output (?full=1), but never fail readiness; e.g a read replica, reads fall back to the primary
+func WithNonFatalChecks(h healthcheck.Handler) *Handler {
+	return &Handler{Handler: h, nonFatal: map[string]healthcheck.Check{}}
+}
+
+type Handler struct {
+	healthcheck.Handler
+
+	mu       sync.RWMutex
+	nonFatal map[string]healthcheck.Check
+}
+
+// AddNonFatalReadinessCheck adds a check that is reported by the readiness endpoint, whose failure doesn't fail readiness
+func (h *Handler) AddNonFatalReadinessCheck(name string, check healthcheck.Check) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nonFatal[name] = check
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/ready" {
+		h.ReadyEndpoint(w, r)
+		return
+	}
+
+	h.Handler.ServeHTTP(w, r)
+}
+
+func (h *Handler) ReadyEndpoint(w http.ResponseWriter, r *http.Request) {
+	rec := &recorder{header: http.Header{}, status: http.StatusOK}
+	h.Handler.ReadyEndpoint(rec, r)
+
+	results := map[string]string{}
+	full := r.URL.Query().Get("full") == "1" && rec.status != http.StatusMethodNotAllowed
+	if full {
+		full = json.Unmarshal(rec.body.Bytes(), &results) == nil
+	}
+
+	if full {
+		h.mu.RLock()
+		for name, check := range h.nonFatal {
+			if err := check(); err != nil {
+				results[name] = err.Error() + " (non-fatal)"
+			} else {
+				results[name] = "OK"
+			}
+		}
+		h.mu.RUnlock()
+
+		rec.body.Reset()
+		encoder := json.NewEncoder(&rec.body)
+		encoder.SetIndent("", "    ")
+		encoder.Encode(results)
+	}
+
+	for k, v := range rec.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(rec.status)
+	w.Write(rec.body.Bytes())
+}
+
+// recorder buffers a response, so it can be completed before it's written
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *recorder) Header() http.Header {
+	return rec.header
+}
+
+func (rec *recorder) WriteHeader(status int) {
+	rec.status = status
+}
+
+func (rec *recorder) Write(b []byte) (int, error) {
+	return rec.body.Write(b)
+}
@@ -0,0 +1,61 @@
+package healthcheck
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/heptiolabs/healthcheck"
+)
+
+func TestHandler_ReadyEndpoint(t *testing.T) {
+	failed := func() error { return errors.New("lagging") }
+	ok := func() error { return nil }
+
+	tests := []struct {
+		name        string
+		readiness   healthcheck.Check
+		nonFatal    healthcheck.Check
+		wantStatus  int
+		wantResults map[string]string
+	}{
+		{name: "all ok", readiness: ok, nonFatal: ok, wantStatus: http.StatusOK,
+			wantResults: map[string]string{"db": "OK", "db-replica": "OK"}},
+		{name: "non-fatal failed", readiness: ok, nonFatal: failed, wantStatus: http.StatusOK,
+			wantResults: map[string]string{"db": "OK", "db-replica": "lagging (non-fatal)"}},
+		{name: "fatal failed", readiness: failed, nonFatal: ok, wantStatus: http.StatusServiceUnavailable,
+			wantResults: map[string]string{"db": "lagging", "db-replica": "OK"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := WithNonFatalChecks(healthcheck.NewHandler())
+			h.AddReadinessCheck("db", tt.readiness)
+			h.AddNonFatalReadinessCheck("db-replica", tt.nonFatal)
+
+			for _, url := range []string{"/ready", "/ready?full=1"} {
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+
+				if rec.Code != tt.wantStatus {
+					t.Errorf("%s: expected status %d, got %d", url, tt.wantStatus, rec.Code)
+				}
+
+				want := map[string]string{}
+				if url == "/ready?full=1" {
+					want = tt.wantResults
+				}
+				results := map[string]string{}
+				if err := json.Unmarshal(rec.Body.Bytes(), &results); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(results, want) {
+					t.Errorf("%s: expected %v, got %v", url, want, results)
+				}
+			}
+		})
+	}
+}
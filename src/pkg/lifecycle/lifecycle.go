@@ -0,0 +1,136 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrShuttingDown is reported by the readiness check once shutdown started
+var ErrShuttingDown = errors.New("shutting down")
+
+// New creates a Manager. Once shutdown starts, readiness fails for readinessDelay before the hooks run,
+// giving load balancers (e.g Kubernetes endpoints) the time to stop routing requests to us.
+// SIGINT/SIGTERM are caught from now on, a signal received while the app is starting is handled once Wait is called
+func New(logger *zap.Logger, readinessDelay time.Duration) *Manager {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	return &Manager{logger: logger, readinessDelay: readinessDelay, signals: signals, shutdown: make(chan struct{}),
+		workersCtx: workersCtx, stopWorkers: stopWorkers}
+}
+
+// Manager shuts the app down on SIGINT/SIGTERM, running its hooks one after the other
+type Manager struct {
+	logger         *zap.Logger
+	readinessDelay time.Duration
+	signals        chan os.Signal
+
+	mu           sync.Mutex
+	hooks        []hook
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+
+	workersCtx  context.Context
+	stopWorkers context.CancelFunc
+	workers     sync.WaitGroup
+}
+
+type hook struct {
+	name    string
+	timeout time.Duration
+	fn      func(c context.Context) error
+}
+
+// Append registers fn to run on shutdown, after the hooks appended before it.
+// fn's context is done once timeout passes, the next hook runs either way
+func (m *Manager) Append(name string, timeout time.Duration, fn func(c context.Context) error) {
+	m.mu.Lock()
+	m.hooks = append(m.hooks, hook{name: name, timeout: timeout, fn: fn})
+	m.mu.Unlock()
+}
+
+// Go runs a background worker (e.g a relay or a monitor), it should return once c is done. See StopWorkers
+func (m *Manager) Go(fn func(c context.Context)) {
+	m.workers.Add(1)
+	go func() {
+		defer m.workers.Done()
+		fn(m.workersCtx)
+	}()
+}
+
+// StopWorkers is a hook that stops the workers started by Go, and waits for them to return
+func (m *Manager) StopWorkers(c context.Context) error {
+	m.stopWorkers()
+
+	done := make(chan struct{})
+	go func() {
+		m.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-c.Done():
+		return c.Err()
+	}
+}
+
+// ReadinessCheck fails once shutdown started, add it to the readiness checks
+func (m *Manager) ReadinessCheck() error {
+	select {
+	case <-m.shutdown:
+		return ErrShuttingDown
+	default:
+		return nil
+	}
+}
+
+// Shutdown starts the shutdown without a signal, e.g once a server fails
+func (m *Manager) Shutdown() {
+	m.shutdownOnce.Do(func() { close(m.shutdown) })
+}
+
+// Wait blocks until SIGINT/SIGTERM is received or Shutdown is called, then runs the hooks
+func (m *Manager) Wait() {
+	defer signal.Stop(m.signals)
+
+	select {
+	case sig := <-m.signals:
+		m.logger.Info("Shutting down", zap.String("signal", sig.String()))
+		m.Shutdown()
+	case <-m.shutdown:
+		m.logger.Info("Shutting down")
+	}
+
+	time.Sleep(m.readinessDelay)
+
+	m.mu.Lock()
+	hooks := m.hooks
+	m.mu.Unlock()
+
+	for _, h := range hooks {
+		m.run(h)
+	}
+}
+
+func (m *Manager) run(h hook) {
+	c, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := h.fn(c); err != nil {
+		m.logger.Error("Shutdown hook failed", zap.String("hook", h.name), zap.Error(err))
+		return
+	}
+
+	m.logger.Debug("Shutdown hook done", zap.String("hook", h.name), zap.Duration("duration", time.Since(start)))
+}
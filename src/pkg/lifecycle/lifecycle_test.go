@@ -0,0 +1,156 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestManager_Wait(t *testing.T) {
+	tests := []struct {
+		name string
+		// Hooks, in the order they're appended
+		hooks     []string
+		fail      string // Hook that fails
+		slow      string // Hook that runs out of time
+		wantOrder []string
+	}{
+		{name: "in order", hooks: []string{"http", "grpc", "workers", "db"}, wantOrder: []string{"http", "grpc", "workers", "db"}},
+		{name: "failed hook", hooks: []string{"http", "grpc", "db"}, fail: "grpc", wantOrder: []string{"http", "grpc", "db"}},
+		{name: "timed out hook", hooks: []string{"http", "grpc", "db"}, slow: "http", wantOrder: []string{"http", "grpc", "db"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(zap.NewNop(), 0)
+
+			var mu sync.Mutex
+			var order []string
+			for _, name := range tt.hooks {
+				name := name
+				m.Append(name, 10*time.Millisecond, func(c context.Context) error {
+					mu.Lock()
+					order = append(order, name)
+					mu.Unlock()
+
+					switch name {
+					case tt.fail:
+						return errors.New("failed")
+					case tt.slow:
+						<-c.Done()
+						return c.Err()
+					}
+					return nil
+				})
+			}
+
+			if err := m.ReadinessCheck(); err != nil {
+				t.Fatalf("expected to be ready, got %v", err)
+			}
+
+			m.Shutdown()
+			m.Wait()
+
+			if !reflect.DeepEqual(order, tt.wantOrder) {
+				t.Errorf("expected hooks %v, got %v", tt.wantOrder, order)
+			}
+			if err := m.ReadinessCheck(); err != ErrShuttingDown {
+				t.Errorf("expected %v, got %v", ErrShuttingDown, err)
+			}
+		})
+	}
+}
+
+func TestManager_ReadinessDelay(t *testing.T) {
+	m := New(zap.NewNop(), 50*time.Millisecond)
+
+	var ranAt time.Time
+	m.Append("http", time.Second, func(c context.Context) error {
+		ranAt = time.Now()
+		return nil
+	})
+
+	start := time.Now()
+	m.Shutdown()
+	// Readiness fails during the delay, before the hooks run
+	readiness := m.ReadinessCheck()
+	m.Wait()
+
+	if readiness != ErrShuttingDown {
+		t.Errorf("expected %v, got %v", ErrShuttingDown, readiness)
+	}
+	if d := ranAt.Sub(start); d < 50*time.Millisecond {
+		t.Errorf("expected the hooks to run after the readiness delay, ran after %s", d)
+	}
+}
+
+func TestManager_StopWorkers(t *testing.T) {
+	m := New(zap.NewNop(), 0)
+
+	var mu sync.Mutex
+	var order []string
+	record := func(s string) {
+		mu.Lock()
+		order = append(order, s)
+		mu.Unlock()
+	}
+
+	m.Go(func(c context.Context) {
+		<-c.Done()
+		record("worker")
+	})
+	m.Append("servers", time.Second, func(c context.Context) error {
+		record("servers")
+		return nil
+	})
+	m.Append("workers", time.Second, m.StopWorkers)
+	m.Append("db", time.Second, func(c context.Context) error {
+		record("db")
+		return nil
+	})
+
+	m.Shutdown()
+	m.Wait()
+
+	// Workers keep running until their hook, and are done before the next one
+	if want := []string{"servers", "worker", "db"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("expected %v, got %v", want, order)
+	}
+}
+
+func TestManager_SignalBeforeWait(t *testing.T) {
+	m := New(zap.NewNop(), 0)
+
+	ran := false
+	m.Append("http", time.Second, func(c context.Context) error {
+		ran = true
+		return nil
+	})
+
+	// A signal received while starting up, before Wait, isn't lost nor does it kill the process
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		m.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the signal to start the shutdown")
+	}
+	if !ran {
+		t.Error("expected the hooks to run")
+	}
+}
@@ -0,0 +1,90 @@
+package replica
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+var ErrNotReplicating = errors.New("replica is not replicating")
+
+// mysqlParseError is returned for statements a server doesn't know, e.g SHOW REPLICA STATUS before MySQL 8.0.22
+const mysqlParseError = 1064
+
+// MySQLLag reads Seconds_Behind_Source from SHOW REPLICA STATUS, replication must be running.
+// Servers that predate it are read using SHOW SLAVE STATUS (Seconds_Behind_Master), which MySQL 8.4 removed
+func MySQLLag(ctx context.Context, db *sql.DB) (time.Duration, error) {
+	lag, err := mysqlLag(ctx, db, "SHOW REPLICA STATUS")
+
+	var me *mysql.MySQLError
+	if errors.As(err, &me) && me.Number == mysqlParseError {
+		return mysqlLag(ctx, db, "SHOW SLAVE STATUS")
+	}
+
+	return lag, err
+}
+
+func mysqlLag(ctx context.Context, db *sql.DB, query string) (time.Duration, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+		return 0, ErrNotReplicating
+	}
+
+	// The status has dozens of columns, only one is needed. MariaDB keeps the old column name
+	cols, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+
+	values := make([]interface{}, len(cols))
+	var lag sql.NullInt64
+	for i, col := range cols {
+		if col == "Seconds_Behind_Source" || col == "Seconds_Behind_Master" {
+			values[i] = &lag
+		} else {
+			values[i] = new(sql.RawBytes)
+		}
+	}
+
+	if err := rows.Scan(values...); err != nil {
+		return 0, err
+	}
+
+	// NULL while the SQL thread isn't running
+	if !lag.Valid {
+		return 0, ErrNotReplicating
+	}
+
+	return time.Duration(lag.Int64) * time.Second, nil
+}
+
+// PostgresLag is the time since the last replayed transaction. A replica that replayed all it received is caught up,
+// its lag is 0 however long ago the primary was last written to
+func PostgresLag(ctx context.Context, db *sql.DB) (time.Duration, error) {
+	var lag sql.NullFloat64
+	err := db.QueryRowContext(ctx, `SELECT CASE
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())
+	END`).Scan(&lag)
+	if err != nil {
+		return 0, err
+	}
+
+	// NULL when not in recovery, i.e not a replica
+	if !lag.Valid {
+		return 0, ErrNotReplicating
+	}
+
+	return time.Duration(lag.Float64 * float64(time.Second)), nil
+}
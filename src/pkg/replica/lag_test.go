@@ -0,0 +1,105 @@
+package replica
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestMySQLLag(t *testing.T) {
+	errRefused := errors.New("connection refused")
+
+	tests := []struct {
+		name string
+		// expect sets the statements the server receives, and their result
+		expect  func(mock sqlmock.Sqlmock)
+		wantLag time.Duration
+		wantErr error
+	}{
+		{name: "replica status", wantLag: 3 * time.Second, expect: func(mock sqlmock.Sqlmock) {
+			mock.ExpectQuery("SHOW REPLICA STATUS").WillReturnRows(
+				sqlmock.NewRows([]string{"Replica_IO_State", "Seconds_Behind_Source"}).AddRow("Waiting", 3))
+		}},
+		{name: "MariaDB replica status", wantLag: 2 * time.Second, expect: func(mock sqlmock.Sqlmock) {
+			mock.ExpectQuery("SHOW REPLICA STATUS").WillReturnRows(
+				sqlmock.NewRows([]string{"Slave_IO_State", "Seconds_Behind_Master"}).AddRow("Waiting", 2))
+		}},
+		{name: "older server", wantLag: time.Second, expect: func(mock sqlmock.Sqlmock) {
+			mock.ExpectQuery("SHOW REPLICA STATUS").WillReturnError(&mysql.MySQLError{Number: mysqlParseError, Message: "syntax error"})
+			mock.ExpectQuery("SHOW SLAVE STATUS").WillReturnRows(
+				sqlmock.NewRows([]string{"Slave_IO_State", "Seconds_Behind_Master"}).AddRow("Waiting", 1))
+		}},
+		{name: "not a replica", wantErr: ErrNotReplicating, expect: func(mock sqlmock.Sqlmock) {
+			mock.ExpectQuery("SHOW REPLICA STATUS").WillReturnRows(sqlmock.NewRows([]string{"Seconds_Behind_Source"}))
+		}},
+		{name: "stopped", wantErr: ErrNotReplicating, expect: func(mock sqlmock.Sqlmock) {
+			mock.ExpectQuery("SHOW REPLICA STATUS").WillReturnRows(
+				sqlmock.NewRows([]string{"Seconds_Behind_Source"}).AddRow(nil))
+		}},
+		{name: "connection failed", wantErr: errRefused, expect: func(mock sqlmock.Sqlmock) {
+			mock.ExpectQuery("SHOW REPLICA STATUS").WillReturnError(errRefused)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			tt.expect(mock)
+
+			lag, err := MySQLLag(context.Background(), db)
+			if err != tt.wantErr {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if lag != tt.wantLag {
+				t.Errorf("expected lag %s, got %s", tt.wantLag, lag)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPostgresLag(t *testing.T) {
+	tests := []struct {
+		name    string
+		lag     driver.Value
+		wantLag time.Duration
+		wantErr error
+	}{
+		{name: "lagging", lag: 1.5, wantLag: 1500 * time.Millisecond},
+		{name: "caught up", lag: 0.0, wantLag: 0},
+		{name: "not a replica", lag: nil, wantErr: ErrNotReplicating},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			// Caught up replicas aren't measured by the time since their last replayed transaction, the primary may be idle
+			mock.ExpectQuery(`WHEN pg_last_wal_receive_lsn\(\) = pg_last_wal_replay_lsn\(\) THEN 0`).
+				WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(tt.lag))
+
+			lag, err := PostgresLag(context.Background(), db)
+			if err != tt.wantErr {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if lag != tt.wantLag {
+				t.Errorf("expected lag %s, got %s", tt.wantLag, lag)
+			}
+		})
+	}
+}
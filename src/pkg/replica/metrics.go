@@ -0,0 +1,42 @@
+package replica
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	healthyDesc = prometheus.NewDesc("database_replica_healthy",
+		"Whether the replica serves reads: its last check succeeded and it's within the max lag.", []string{"replica"}, nil)
+	lagDesc = prometheus.NewDesc("database_replica_lag_seconds",
+		"Replication lag of the replica as of its last check, missing if the check failed.", []string{"replica"}, nil)
+)
+
+// NewCollector reports the health and lag of the replicas of r
+func NewCollector(r *Router) prometheus.Collector {
+	return &collector{r}
+}
+
+type collector struct {
+	r *Router
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- healthyDesc
+	ch <- lagDesc
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	for _, replica := range c.r.replicas {
+		lag, err := replica.Lag()
+
+		healthy := 0.0
+		if c.r.Check(replica) == nil {
+			healthy = 1
+		}
+		ch <- prometheus.MustNewConstMetric(healthyDesc, prometheus.GaugeValue, healthy, replica.Name)
+
+		if err == nil {
+			ch <- prometheus.MustNewConstMetric(lagDesc, prometheus.GaugeValue, lag.Seconds(), replica.Name)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package replica
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var errNotChecked = errors.New("replica wasn't checked yet")
+
+// LagFunc returns how far behind its primary a replica is
+type LagFunc func(ctx context.Context, db *sql.DB) (time.Duration, error)
+
+type primaryKey struct{}
+
+// WithPrimary marks c as read-your-writes, reads using it are routed to the primary
+func WithPrimary(c context.Context) context.Context {
+	return context.WithValue(c, primaryKey{}, true)
+}
+
+func isPrimary(c context.Context) bool {
+	primary, _ := c.Value(primaryKey{}).(bool)
+	return primary
+}
+
+type Replica struct {
+	Name string
+	DB   *sql.DB
+
+	mu      sync.RWMutex
+	lag     time.Duration
+	lastErr error
+}
+
+// Lag returns the replica's lag as of the last check, along with the check's error
+func (r *Replica) Lag() (time.Duration, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.lag, r.lastErr
+}
+
+func (r *Replica) set(lag time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lag, r.lastErr = lag, err
+}
+
+// New creates a router that sends writes to the primary and spreads reads across replicas, round-robin.
+// Replicas that failed their last check, or lag more than maxLag behind, are skipped until they catch up.
+// Without replicas, everything is routed to the primary
+func New(primary *sql.DB, replicas []*Replica, maxLag time.Duration, lag LagFunc) *Router {
+	for _, replica := range replicas {
+		replica.set(0, errNotChecked)
+	}
+
+	return &Router{primary: primary, replicas: replicas, maxLag: maxLag, lagFunc: lag}
+}
+
+type Router struct {
+	primary  *sql.DB
+	replicas []*Replica
+	maxLag   time.Duration
+	lagFunc  LagFunc
+	next     uint32
+}
+
+// Primary is used for writes, and for reads that are followed by a write
+func (r *Router) Primary() *sql.DB {
+	return r.primary
+}
+
+// Reader returns a healthy replica, or the primary if there is none or c is read-your-writes (see WithPrimary)
+func (r *Router) Reader(c context.Context) *sql.DB {
+	if len(r.replicas) == 0 || isPrimary(c) {
+		return r.primary
+	}
+
+	start := atomic.AddUint32(&r.next, 1)
+	for i := range r.replicas {
+		replica := r.replicas[(int(start)+i)%len(r.replicas)]
+		if r.Check(replica) == nil {
+			return replica.DB
+		}
+	}
+
+	return r.primary
+}
+
+// Check returns why replica doesn't serve reads, nil if it passed its last check and is within the max lag
+func (r *Router) Check(replica *Replica) error {
+	lag, err := replica.Lag()
+	if err != nil {
+		return err
+	}
+	if lag > r.maxLag {
+		return fmt.Errorf("replica lags %s behind, more than %s", lag, r.maxLag)
+	}
+
+	return nil
+}
+
+// Replicas returns all replicas, healthy or not
+func (r *Router) Replicas() []*Replica {
+	return r.replicas
+}
+
+// Monitor checks the replicas' lag every interval, until c is done
+func (r *Router) Monitor(c context.Context, interval time.Duration) {
+	if len(r.replicas) == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		r.check(c, interval)
+
+		select {
+		case <-c.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Router) check(c context.Context, timeout time.Duration) {
+	c, cancel := context.WithTimeout(c, timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, replica := range r.replicas {
+		wg.Add(1)
+		go func(replica *Replica) {
+			defer wg.Done()
+			replica.set(r.lagFunc(c, replica.DB))
+		}(replica)
+	}
+	wg.Wait()
+}
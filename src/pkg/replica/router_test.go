@@ -0,0 +1,72 @@
+package replica
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRouter(t *testing.T) {
+	primary := &sql.DB{}
+
+	tests := []struct {
+		name        string
+		lag         time.Duration
+		err         error
+		primary     bool // Read-your-writes
+		wantReplica bool
+		wantHealthy string
+		wantLag     string // Empty if not reported
+	}{
+		{name: "healthy", lag: 100 * time.Millisecond, wantReplica: true, wantHealthy: "1", wantLag: "0.1"},
+		{name: "read-your-writes", lag: 100 * time.Millisecond, primary: true, wantHealthy: "1", wantLag: "0.1"},
+		{name: "lagging", lag: 2 * time.Second, wantHealthy: "0", wantLag: "2"},
+		{name: "check failed", err: errors.New("connection refused"), wantHealthy: "0"},
+		{name: "not checked yet", err: errNotChecked, wantHealthy: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replica := &Replica{Name: "replica-1", DB: &sql.DB{}}
+			r := New(primary, []*Replica{replica}, time.Second, nil)
+			replica.set(tt.lag, tt.err)
+
+			c := context.Background()
+			if tt.primary {
+				c = WithPrimary(c)
+			}
+			if got := r.Reader(c) == replica.DB; got != tt.wantReplica {
+				t.Errorf("expected reading from the replica %v, got %v", tt.wantReplica, got)
+			}
+
+			// Reported as a non-fatal readiness check
+			if err := r.Check(replica); (err == nil) != (tt.wantHealthy == "1") {
+				t.Errorf("expected healthy %s, got %v", tt.wantHealthy, err)
+			}
+
+			healthy := `
+# HELP database_replica_healthy Whether the replica serves reads: its last check succeeded and it's within the max lag.
+# TYPE database_replica_healthy gauge
+database_replica_healthy{replica="replica-1"} ` + tt.wantHealthy + "\n"
+			if err := testutil.CollectAndCompare(NewCollector(r), strings.NewReader(healthy), "database_replica_healthy"); err != nil {
+				t.Error(err)
+			}
+
+			var lag string
+			if tt.wantLag != "" {
+				lag = `
+# HELP database_replica_lag_seconds Replication lag of the replica as of its last check, missing if the check failed.
+# TYPE database_replica_lag_seconds gauge
+database_replica_lag_seconds{replica="replica-1"} ` + tt.wantLag + "\n"
+			}
+			if err := testutil.CollectAndCompare(NewCollector(r), strings.NewReader(lag), "database_replica_lag_seconds"); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package tlsconf
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// Config of a TLS listener
+type Config struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is a PEM bundle of the CAs that issue client certificates, set to enable mutual TLS
+	ClientCAFile string
+	// RequireClientCert rejects clients without a certificate, otherwise it's verified only if sent
+	RequireClientCert bool
+	MinVersion        uint16
+}
+
+// ParseVersion parses a TLS version, e.g 1.2
+func ParseVersion(version string) (uint16, error) {
+	switch version {
+	case "1.0":
+		return tls.VersionTLS10, nil
+	case "1.1":
+		return tls.VersionTLS11, nil
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+
+	return 0, fmt.Errorf("unsupported TLS version %q, expected 1.0, 1.1, 1.2 or 1.3", version)
+}
+
+// Reloader serves the certificate and client CAs of a Config, reloaded once their files change
+type Reloader struct {
+	conf Config
+
+	mu       sync.RWMutex
+	current  *tls.Config
+	modTimes map[string]time.Time
+}
+
+// NewReloader loads the files of conf
+func NewReloader(conf Config) (*Reloader, error) {
+	r := &Reloader{conf: conf}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ServerConfig returns a tls.Config that always uses the latest files, e.g for tls.NewListener
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: r.conf.MinVersion,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			return r.current, nil
+		},
+	}
+}
+
+// Reload loads the files again if any of them changed since they were last loaded, and reports whether it did.
+// On failure, the files loaded earlier are kept
+func (r *Reloader) Reload() (bool, error) {
+	modTimes := map[string]time.Time{}
+	for _, file := range []string{r.conf.CertFile, r.conf.KeyFile, r.conf.ClientCAFile} {
+		if file == "" {
+			continue
+		}
+
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, err
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	r.mu.RLock()
+	changed := r.current == nil || !sameModTimes(modTimes, r.modTimes)
+	r.mu.RUnlock()
+	if !changed {
+		return false, nil
+	}
+
+	conf, err := r.load()
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	r.current = conf
+	r.modTimes = modTimes
+	r.mu.Unlock()
+
+	return true, nil
+}
+
+// Run checks the files for changes every interval, until c is done. Failed reloads are passed to onError
+func (r *Reloader) Run(c context.Context, interval time.Duration, onError func(err error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.Reload(); err != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+func (r *Reloader) load() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(r.conf.CertFile, r.conf.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   r.conf.MinVersion,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+
+	if r.conf.ClientCAFile != "" {
+		pem, err := ioutil.ReadFile(r.conf.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		conf.ClientCAs = x509.NewCertPool()
+		if !conf.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", r.conf.ClientCAFile)
+		}
+
+		conf.ClientAuth = tls.VerifyClientCertIfGiven
+		if r.conf.RequireClientCert {
+			conf.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+
+	return conf, nil
+}
+
+func sameModTimes(a map[string]time.Time, b map[string]time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for file, modTime := range a {
+		if !modTime.Equal(b[file]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,235 @@
+package tlsconf
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCert generates a self-signed certificate of serial into certFile and keyFile, modified at modTime
+func writeCert(t *testing.T, certFile, keyFile string, serial int64, modTime time.Time) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:              []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), modTime)
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), modTime)
+}
+
+func writeFile(t *testing.T, file string, data []byte, modTime time.Time) {
+	t.Helper()
+
+	if err := ioutil.WriteFile(file, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	// Set explicitly, the file system's resolution may be too coarse to tell writes apart
+	if err := os.Chtimes(file, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// servedSerial handshakes with a server of conf, and returns the serial number of the certificate it presented
+func servedSerial(t *testing.T, conf *tls.Config) int64 {
+	t.Helper()
+
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+
+	go func() {
+		server := tls.Server(serverConn, conf)
+		server.Handshake()
+		server.Close()
+	}()
+
+	client := tls.Client(clientConn, &tls.Config{InsecureSkipVerify: true})
+	if err := client.Handshake(); err != nil {
+		t.Fatal(err)
+	}
+
+	return client.ConnectionState().PeerCertificates[0].SerialNumber.Int64()
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    uint16
+		wantErr bool
+	}{
+		{version: "1.0", want: tls.VersionTLS10},
+		{version: "1.1", want: tls.VersionTLS11},
+		{version: "1.2", want: tls.VersionTLS12},
+		{version: "1.3", want: tls.VersionTLS13},
+		{version: "", wantErr: true},
+		{version: "1.4", wantErr: true},
+		{version: "TLS1.2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got, err := ParseVersion(tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %x, got %x", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestReloader_Reload(t *testing.T) {
+	loaded := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name string
+		// change the files loaded at first, a certificate of serial 1
+		change      func(t *testing.T, certFile, keyFile string)
+		wantErr     bool
+		wantChanged bool
+		wantSerial  int64
+	}{
+		{name: "unchanged", change: func(t *testing.T, certFile, keyFile string) {}, wantSerial: 1},
+		{name: "touched", wantChanged: true, wantSerial: 1, change: func(t *testing.T, certFile, keyFile string) {
+			if err := os.Chtimes(certFile, time.Now(), time.Now()); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{name: "renewed", wantChanged: true, wantSerial: 2, change: func(t *testing.T, certFile, keyFile string) {
+			writeCert(t, certFile, keyFile, 2, time.Now())
+		}},
+		{name: "key of another certificate", wantErr: true, wantSerial: 1, change: func(t *testing.T, certFile, keyFile string) {
+			otherCert := filepath.Join(filepath.Dir(certFile), "other.pem")
+			writeCert(t, otherCert, keyFile, 2, time.Now())
+		}},
+		{name: "invalid certificate", wantErr: true, wantSerial: 1, change: func(t *testing.T, certFile, keyFile string) {
+			writeFile(t, certFile, []byte("not a certificate"), time.Now())
+		}},
+		{name: "removed", wantErr: true, wantSerial: 1, change: func(t *testing.T, certFile, keyFile string) {
+			if err := os.Remove(keyFile); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+			writeCert(t, certFile, keyFile, 1, loaded)
+
+			r, err := NewReloader(Config{CertFile: certFile, KeyFile: keyFile, MinVersion: tls.VersionTLS12})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The listener's config is created once, and follows the reloads
+			conf := r.ServerConfig()
+			if serial := servedSerial(t, conf); serial != 1 {
+				t.Fatalf("expected serial 1, got %d", serial)
+			}
+
+			tt.change(t, certFile, keyFile)
+			changed, err := r.Reload()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if changed != tt.wantChanged {
+				t.Errorf("expected changed %v, got %v", tt.wantChanged, changed)
+			}
+
+			// On failure, the certificate loaded earlier is still served
+			if serial := servedSerial(t, conf); serial != tt.wantSerial {
+				t.Errorf("expected serial %d, got %d", tt.wantSerial, serial)
+			}
+		})
+	}
+}
+
+func TestReloader_ClientCA(t *testing.T) {
+	tests := []struct {
+		name           string
+		conf           Config
+		caPEM          string
+		wantErr        bool
+		wantClientAuth tls.ClientAuthType
+	}{
+		{name: "no client CA", wantClientAuth: tls.NoClientCert},
+		{name: "optional client certificate", conf: Config{ClientCAFile: "ca.pem"}, wantClientAuth: tls.VerifyClientCertIfGiven},
+		{name: "required client certificate", conf: Config{ClientCAFile: "ca.pem", RequireClientCert: true}, wantClientAuth: tls.RequireAndVerifyClientCert},
+		{name: "no certificates in the bundle", conf: Config{ClientCAFile: "ca.pem"}, caPEM: "not a certificate", wantErr: true},
+		{name: "missing bundle", conf: Config{ClientCAFile: "missing.pem"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			conf := tt.conf
+			conf.CertFile, conf.KeyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+			writeCert(t, conf.CertFile, conf.KeyFile, 1, time.Now())
+
+			// The server's own certificate doubles as the client CA
+			caPEM, err := ioutil.ReadFile(conf.CertFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.caPEM != "" {
+				caPEM = []byte(tt.caPEM)
+			}
+			writeFile(t, filepath.Join(dir, "ca.pem"), caPEM, time.Now())
+			if conf.ClientCAFile != "" {
+				conf.ClientCAFile = filepath.Join(dir, conf.ClientCAFile)
+			}
+
+			r, err := NewReloader(conf)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := r.ServerConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.ClientAuth != tt.wantClientAuth {
+				t.Errorf("expected client auth %v, got %v", tt.wantClientAuth, got.ClientAuth)
+			}
+			if (got.ClientCAs != nil) != (tt.conf.ClientCAFile != "") {
+				t.Errorf("expected client CAs only along with a bundle, got %v", got.ClientCAs)
+			}
+		})
+	}
+}
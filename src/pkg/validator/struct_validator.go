@@ -5,4 +5,5 @@ import "context"
 type StructValidator interface {
 	Struct(interface{}) error
 	StructCtx(context.Context, interface{}) error
+	StructPartialCtx(ctx context.Context, s interface{}, fields ...string) error
 }
@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/app/identity"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/auth"
+	pb "github.com/eldad87/go-boilerplate/src/transport/grpc/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// NewAPIKeyAuthenticator authenticates the API keys issued by keys, as principals bound to the key's tenant
+func NewAPIKeyAuthenticator(keys *identity.APIKeyManager) auth.Authenticator {
+	return auth.NewAPIKeyAuthenticator(func(ctx context.Context, key string) (*auth.Principal, error) {
+		k, err := keys.Verify(ctx, key)
+		if app.ErrorCode(err) == app.ErrCodeUnauthenticated {
+			return nil, auth.InvalidCredentials(err.Error())
+		} else if err != nil {
+			return nil, err
+		}
+
+		return &auth.Principal{
+			Subject:  "apikey:" + strconv.FormatUint(uint64(k.ID), 10),
+			Scopes:   k.Scopes,
+			Roles:    k.Roles,
+			TenantID: &k.TenantID,
+		}, nil
+	})
+}
+
+type APIKeyServer struct {
+	APIKeys *identity.APIKeyManager
+	pb.UnimplementedAPIKeyServer
+}
+
+// Create an API key. It can't be granted scopes nor roles its caller doesn't hold
+func (ks *APIKeyServer) Create(c context.Context, r *pb.APIKeyCreateRequest) (*pb.APIKeyResponse, error) {
+	if err := checkGrantable(c, r.GetName(), r.GetScopes(), r.GetRoles()); err != nil {
+		return nil, err
+	}
+
+	k := &app.APIKey{Name: r.GetName(), Scopes: r.GetScopes(), Roles: r.GetRoles()}
+	if r.GetExpiresAt() != nil {
+		expiresAt, err := ptypes.Timestamp(r.GetExpiresAt())
+		if err != nil {
+			return nil, err
+		}
+		k.ExpiresAt = &expiresAt
+	}
+
+	k, key, err := ks.APIKeys.Create(c, k)
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.apiKeyToProto(k, key)
+}
+
+// Rotate an API key
+func (ks *APIKeyServer) Rotate(c context.Context, r *pb.APIKeyID) (*pb.APIKeyResponse, error) {
+	k, key, err := ks.APIKeys.Rotate(c, uint(r.GetID()))
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.apiKeyToProto(k, key)
+}
+
+// Revoke an API key
+func (ks *APIKeyServer) Revoke(c context.Context, r *pb.APIKeyID) (*empty.Empty, error) {
+	if err := ks.APIKeys.Revoke(c, uint(r.GetID())); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// checkGrantable fails unless the caller holds every scope and role of a new key, so a key can't escalate its creator's privileges
+func checkGrantable(c context.Context, name string, scopes []string, roles []string) error {
+	p, ok := auth.FromContext(c)
+	for _, scope := range scopes {
+		if !ok || !p.HasScope(scope) {
+			return app.NewPermissionDeniedError(app.APIKeyResource, name, "scope "+scope+" can't be granted, the caller doesn't hold it")
+		}
+	}
+	for _, role := range roles {
+		if !ok || !p.HasRole(role) {
+			return app.NewPermissionDeniedError(app.APIKeyResource, name, "role "+role+" can't be granted, the caller doesn't hold it")
+		}
+	}
+
+	return nil
+}
+
+func (ks *APIKeyServer) apiKeyToProto(k *app.APIKey, key string) (*pb.APIKeyResponse, error) {
+	created, err := ptypes.TimestampProto(k.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	pKey := &pb.APIKeyResponse{
+		ID:        uint32(k.ID),
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    k.Scopes,
+		Roles:     k.Roles,
+		CreatedAt: created,
+		Key:       key,
+	}
+	if pKey.ExpiresAt, err = optionalTimestamp(k.ExpiresAt); err != nil {
+		return nil, err
+	}
+	if pKey.LastUsedAt, err = optionalTimestamp(k.LastUsedAt); err != nil {
+		return nil, err
+	}
+
+	return pKey, nil
+}
+
+// optionalTimestamp converts a time, nil if there is none
+func optionalTimestamp(t *time.Time) (*tspb.Timestamp, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	return ptypes.TimestampProto(*t)
+}
@@ -0,0 +1,102 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/app/identity"
+	"github.com/eldad87/go-boilerplate/src/app/memory"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/auth"
+	transport "github.com/eldad87/go-boilerplate/src/transport/grpc"
+	pb "github.com/eldad87/go-boilerplate/src/transport/grpc/proto"
+	v10validator "github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAPIKeyServer_Create(t *testing.T) {
+	admin := &auth.Principal{Subject: "admin", Scopes: []string{"apikey.admin", "visit.read"}, Roles: []string{"editor"}}
+
+	tests := []struct {
+		name        string
+		principal   *auth.Principal
+		scopes      []string
+		roles       []string
+		wantErrCode string
+	}{
+		{name: "no privileges", principal: admin},
+		{name: "held scopes and roles", principal: admin, scopes: []string{"visit.read"}, roles: []string{"editor"}},
+		{name: "scope not held", principal: admin, scopes: []string{"visit.read", "visit.audit"}, wantErrCode: app.ErrCodePermissionDenied},
+		{name: "role not held", principal: admin, roles: []string{"auditor"}, wantErrCode: app.ErrCodePermissionDenied},
+		{name: "unauthenticated", scopes: []string{"visit.read"}, wantErrCode: app.ErrCodePermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks := &transport.APIKeyServer{APIKeys: identity.NewAPIKeyManager(memory.NewAPIKeyService(), v10validator.New(), []byte("secret"))}
+
+			c := app.WithTenant(context.Background(), testTenant)
+			if tt.principal != nil {
+				c = auth.NewContext(c, tt.principal)
+			}
+
+			res, err := ks.Create(c, &pb.APIKeyCreateRequest{Name: "ci", Scopes: tt.scopes, Roles: tt.roles})
+			if code := app.ErrorCode(err); code != tt.wantErrCode {
+				t.Fatalf("expected error code %q, got %v", tt.wantErrCode, err)
+			}
+			if err == nil && res.GetKey() == "" {
+				t.Error("expected the plain key")
+			}
+		})
+	}
+}
+
+// failingAPIKeyService fails every lookup, e.g the database is down
+type failingAPIKeyService struct {
+	app.APIKeyService
+}
+
+func (failingAPIKeyService) GetByPrefix(c context.Context, prefix string) (*app.APIKey, error) {
+	return nil, errors.New("dial tcp 10.0.0.1:3306: connection refused")
+}
+
+func TestNewAPIKeyAuthenticator(t *testing.T) {
+	keys := memory.NewAPIKeyService()
+	m := identity.NewAPIKeyManager(keys, v10validator.New(), []byte("secret"))
+	_, key, err := m.Create(app.WithTenant(context.Background(), testTenant), &app.APIKey{Name: "ci"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                   string
+		keys                   app.APIKeyService
+		key                    string
+		wantErr                bool
+		wantInvalidCredentials bool
+	}{
+		{name: "valid", keys: keys, key: key},
+		{name: "wrong secret", keys: keys, key: key[:len(key)-1] + "x", wantErr: true, wantInvalidCredentials: true},
+		{name: "store failure", keys: failingAPIKeyService{keys}, key: key, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := transport.NewAPIKeyAuthenticator(identity.NewAPIKeyManager(tt.keys, v10validator.New(), []byte("secret")))
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.APIKeyMetadataKey, tt.key))
+			p, err := a.Authenticate(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			// Only rejected keys are reported as such, a failing store isn't the client's fault
+			if invalid := errors.Is(err, auth.ErrInvalidCredentials); invalid != tt.wantInvalidCredentials {
+				t.Fatalf("expected invalid credentials %v, got %v", tt.wantInvalidCredentials, err)
+			}
+			if err == nil && (p.TenantID == nil || *p.TenantID != testTenant) {
+				t.Errorf("expected the principal to be bound to %s, got %+v", testTenant, p)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/auth"
+	pb "github.com/eldad87/go-boilerplate/src/transport/grpc/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IdentityServer struct {
+	IdentityService app.IdentityService
+	pb.UnimplementedIdentityServer
+}
+
+// Register an account, if the tenant is open to self-registration
+func (is *IdentityServer) Register(c context.Context, r *pb.RegisterRequest) (*pb.AccountResponse, error) {
+	a, err := is.IdentityService.Register(c, &app.Credentials{Email: r.GetEmail(), Password: r.GetPassword()})
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := ptypes.TimestampProto(a.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AccountResponse{ID: uint32(a.ID), Email: a.Email, Roles: a.Roles, CreatedAt: created}, nil
+}
+
+// Log in using an email and a password
+func (is *IdentityServer) Login(c context.Context, r *pb.LoginRequest) (*pb.TokensResponse, error) {
+	tokens, err := is.IdentityService.Login(c, &app.Credentials{Email: r.GetEmail(), Password: r.GetPassword()})
+	if err != nil {
+		return nil, err
+	}
+
+	return is.tokensToProto(tokens)
+}
+
+// Change the password of the authenticated account
+func (is *IdentityServer) ChangePassword(c context.Context, r *pb.ChangePasswordRequest) (*empty.Empty, error) {
+	principal, ok := auth.FromContext(c)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+
+	// Issued by us, the subject is the account ID
+	accountID, err := strconv.ParseUint(principal.Subject, 10, 0)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, "not an account token")
+	}
+
+	if err := is.IdentityService.ChangePassword(c, uint(accountID), r.GetOldPassword(), r.GetNewPassword()); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// Exchange a refresh token for new tokens
+func (is *IdentityServer) RefreshToken(c context.Context, r *pb.RefreshTokenRequest) (*pb.TokensResponse, error) {
+	tokens, err := is.IdentityService.RefreshToken(c, r.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return is.tokensToProto(tokens)
+}
+
+func (is *IdentityServer) tokensToProto(tokens *app.Tokens) (*pb.TokensResponse, error) {
+	expires, err := ptypes.TimestampProto(tokens.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TokensResponse{AccessToken: tokens.AccessToken, RefreshToken: tokens.RefreshToken, ExpiresAt: expires}, nil
+}
@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: src/transport/grpc/proto/api_key.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = ptypes.DynamicAny{}
+)
+
+// Validate checks the field values on APIKeyID with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *APIKeyID) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetID() <= 0 {
+		return APIKeyIDValidationError{
+			field:  "ID",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// APIKeyIDValidationError is the validation error returned by
+// APIKeyID.Validate if the designated constraints aren't met.
+type APIKeyIDValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyIDValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyIDValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyIDValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyIDValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyIDValidationError) ErrorName() string { return "APIKeyIDValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIKeyIDValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKeyID.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyIDValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyIDValidationError{}
+
+// Validate checks the field values on APIKeyCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *APIKeyCreateRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		return APIKeyCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+	}
+
+	if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyCreateRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// APIKeyCreateRequestValidationError is the validation error returned by
+// APIKeyCreateRequest.Validate if the designated constraints aren't met.
+type APIKeyCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyCreateRequestValidationError) ErrorName() string {
+	return "APIKeyCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e APIKeyCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKeyCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyCreateRequestValidationError{}
+
+// Validate checks the field values on APIKeyResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *APIKeyResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ID
+
+	// no validation rules for Name
+
+	// no validation rules for Prefix
+
+	if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyResponseValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Key
+
+	return nil
+}
+
+// APIKeyResponseValidationError is the validation error returned by
+// APIKeyResponse.Validate if the designated constraints aren't met.
+type APIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyResponseValidationError) ErrorName() string { return "APIKeyResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyResponseValidationError{}
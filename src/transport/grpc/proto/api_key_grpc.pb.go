@@ -0,0 +1,180 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// APIKeyClient is the client API for APIKey service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type APIKeyClient interface {
+	// Create a key, the plain key is only returned now. It can't be granted scopes nor roles the caller doesn't hold
+	Create(ctx context.Context, in *APIKeyCreateRequest, opts ...grpc.CallOption) (*APIKeyResponse, error)
+	// Replace a key, the previous key stops working right away. The plain key is only returned now
+	Rotate(ctx context.Context, in *APIKeyID, opts ...grpc.CallOption) (*APIKeyResponse, error)
+	// Revoke a key
+	Revoke(ctx context.Context, in *APIKeyID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type aPIKeyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIKeyClient(cc grpc.ClientConnInterface) APIKeyClient {
+	return &aPIKeyClient{cc}
+}
+
+func (c *aPIKeyClient) Create(ctx context.Context, in *APIKeyCreateRequest, opts ...grpc.CallOption) (*APIKeyResponse, error) {
+	out := new(APIKeyResponse)
+	err := c.cc.Invoke(ctx, "/pb.APIKey/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyClient) Rotate(ctx context.Context, in *APIKeyID, opts ...grpc.CallOption) (*APIKeyResponse, error) {
+	out := new(APIKeyResponse)
+	err := c.cc.Invoke(ctx, "/pb.APIKey/Rotate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyClient) Revoke(ctx context.Context, in *APIKeyID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pb.APIKey/Revoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIKeyServer is the server API for APIKey service.
+// All implementations must embed UnimplementedAPIKeyServer
+// for forward compatibility
+type APIKeyServer interface {
+	// Create a key, the plain key is only returned now. It can't be granted scopes nor roles the caller doesn't hold
+	Create(context.Context, *APIKeyCreateRequest) (*APIKeyResponse, error)
+	// Replace a key, the previous key stops working right away. The plain key is only returned now
+	Rotate(context.Context, *APIKeyID) (*APIKeyResponse, error)
+	// Revoke a key
+	Revoke(context.Context, *APIKeyID) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAPIKeyServer()
+}
+
+// UnimplementedAPIKeyServer must be embedded to have forward compatible implementations.
+type UnimplementedAPIKeyServer struct {
+}
+
+func (UnimplementedAPIKeyServer) Create(context.Context, *APIKeyCreateRequest) (*APIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedAPIKeyServer) Rotate(context.Context, *APIKeyID) (*APIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rotate not implemented")
+}
+func (UnimplementedAPIKeyServer) Revoke(context.Context, *APIKeyID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedAPIKeyServer) mustEmbedUnimplementedAPIKeyServer() {}
+
+// UnsafeAPIKeyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to APIKeyServer will
+// result in compilation errors.
+type UnsafeAPIKeyServer interface {
+	mustEmbedUnimplementedAPIKeyServer()
+}
+
+func RegisterAPIKeyServer(s grpc.ServiceRegistrar, srv APIKeyServer) {
+	s.RegisterService(&APIKey_ServiceDesc, srv)
+}
+
+func _APIKey_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APIKeyCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.APIKey/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServer).Create(ctx, req.(*APIKeyCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKey_Rotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APIKeyID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServer).Rotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.APIKey/Rotate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServer).Rotate(ctx, req.(*APIKeyID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKey_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APIKeyID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.APIKey/Revoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServer).Revoke(ctx, req.(*APIKeyID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// APIKey_ServiceDesc is the grpc.ServiceDesc for APIKey service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var APIKey_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.APIKey",
+	HandlerType: (*APIKeyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _APIKey_Create_Handler,
+		},
+		{
+			MethodName: "Rotate",
+			Handler:    _APIKey_Rotate_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _APIKey_Revoke_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "src/transport/grpc/proto/api_key.proto",
+}
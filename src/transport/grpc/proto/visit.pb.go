@@ -7,10 +7,15 @@
 package pb
 
 import (
+	_ "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/auth"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,12 +28,118 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type VisitEvent_EventType int32
+
+const (
+	VisitEvent_UNKNOWN VisitEvent_EventType = 0
+	VisitEvent_CREATED VisitEvent_EventType = 1
+	VisitEvent_UPDATED VisitEvent_EventType = 2
+	VisitEvent_DELETED VisitEvent_EventType = 3
+)
+
+// Enum value maps for VisitEvent_EventType.
+var (
+	VisitEvent_EventType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "DELETED",
+	}
+	VisitEvent_EventType_value = map[string]int32{
+		"UNKNOWN": 0,
+		"CREATED": 1,
+		"UPDATED": 2,
+		"DELETED": 3,
+	}
+)
+
+func (x VisitEvent_EventType) Enum() *VisitEvent_EventType {
+	p := new(VisitEvent_EventType)
+	*p = x
+	return p
+}
+
+func (x VisitEvent_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VisitEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_transport_grpc_proto_visit_proto_enumTypes[0].Descriptor()
+}
+
+func (VisitEvent_EventType) Type() protoreflect.EnumType {
+	return &file_src_transport_grpc_proto_visit_proto_enumTypes[0]
+}
+
+func (x VisitEvent_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VisitEvent_EventType.Descriptor instead.
+func (VisitEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type VisitAudit_ActionType int32
+
+const (
+	VisitAudit_UNKNOWN VisitAudit_ActionType = 0
+	VisitAudit_CREATED VisitAudit_ActionType = 1
+	VisitAudit_UPDATED VisitAudit_ActionType = 2
+	VisitAudit_DELETED VisitAudit_ActionType = 3
+	VisitAudit_PURGED  VisitAudit_ActionType = 4
+)
+
+// Enum value maps for VisitAudit_ActionType.
+var (
+	VisitAudit_ActionType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "DELETED",
+		4: "PURGED",
+	}
+	VisitAudit_ActionType_value = map[string]int32{
+		"UNKNOWN": 0,
+		"CREATED": 1,
+		"UPDATED": 2,
+		"DELETED": 3,
+		"PURGED":  4,
+	}
+)
+
+func (x VisitAudit_ActionType) Enum() *VisitAudit_ActionType {
+	p := new(VisitAudit_ActionType)
+	*p = x
+	return p
+}
+
+func (x VisitAudit_ActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VisitAudit_ActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_transport_grpc_proto_visit_proto_enumTypes[1].Descriptor()
+}
+
+func (VisitAudit_ActionType) Type() protoreflect.EnumType {
+	return &file_src_transport_grpc_proto_visit_proto_enumTypes[1]
+}
+
+func (x VisitAudit_ActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VisitAudit_ActionType.Descriptor instead.
+func (VisitAudit_ActionType) EnumDescriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{13, 0}
+}
+
 type VisitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        uint32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	FirstName string `protobuf:"bytes,2,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
 	LastName  string `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
 }
@@ -65,27 +176,148 @@ func (*VisitRequest) Descriptor() ([]byte, []int) {
 	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *VisitRequest) GetID() uint32 {
+func (x *VisitRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *VisitRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+// Fields are validated according to UpdateMask
+type VisitPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	FirstName string `protobuf:"bytes,2,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
+	LastName  string `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
+	// Expected version, optional
+	Version uint32 `protobuf:"varint,4,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (x *VisitPatch) Reset() {
+	*x = VisitPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitPatch) ProtoMessage() {}
+
+func (x *VisitPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitPatch.ProtoReflect.Descriptor instead.
+func (*VisitPatch) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VisitPatch) GetID() uint32 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *VisitRequest) GetFirstName() string {
+func (x *VisitPatch) GetFirstName() string {
 	if x != nil {
 		return x.FirstName
 	}
 	return ""
 }
 
-func (x *VisitRequest) GetLastName() string {
+func (x *VisitPatch) GetLastName() string {
 	if x != nil {
 		return x.LastName
 	}
 	return ""
 }
 
+func (x *VisitPatch) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type VisitUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Visit      *VisitPatch            `protobuf:"bytes,1,opt,name=Visit,proto3" json:"Visit,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=UpdateMask,proto3" json:"UpdateMask,omitempty"`
+}
+
+func (x *VisitUpdateRequest) Reset() {
+	*x = VisitUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitUpdateRequest) ProtoMessage() {}
+
+func (x *VisitUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitUpdateRequest.ProtoReflect.Descriptor instead.
+func (*VisitUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VisitUpdateRequest) GetVisit() *VisitPatch {
+	if x != nil {
+		return x.Visit
+	}
+	return nil
+}
+
+func (x *VisitUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type VisitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -96,12 +328,13 @@ type VisitResponse struct {
 	LastName  string                 `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	Version   uint32                 `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
 }
 
 func (x *VisitResponse) Reset() {
 	*x = VisitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[1]
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -114,7 +347,7 @@ func (x *VisitResponse) String() string {
 func (*VisitResponse) ProtoMessage() {}
 
 func (x *VisitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[1]
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -127,7 +360,7 @@ func (x *VisitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VisitResponse.ProtoReflect.Descriptor instead.
 func (*VisitResponse) Descriptor() ([]byte, []int) {
-	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{1}
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *VisitResponse) GetID() uint32 {
@@ -165,109 +398,1446 @@ func (x *VisitResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-var File_src_transport_grpc_proto_visit_proto protoreflect.FileDescriptor
+func (x *VisitResponse) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
 
-var file_src_transport_grpc_proto_visit_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x73, 0x72, 0x63, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x73, 0x72,
-	0x63, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x73, 0x0a, 0x0c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x00, 0x52, 0x02, 0x49, 0x44, 0x12, 0x25,
-	0x0a, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x02, 0x52, 0x09, 0x46, 0x69, 0x72, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x02,
-	0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x0d, 0x56,
-	0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
-	0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0x93, 0x01, 0x0a, 0x05, 0x56,
-	0x69, 0x73, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x06, 0x2e, 0x70, 0x62,
-	0x2e, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
-	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x12, 0x50,
-	0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73,
-	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1e, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x3a, 0x01, 0x2a,
-	0x5a, 0x0e, 0x1a, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x3a, 0x01, 0x2a,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type VisitBatchGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDs []uint32 `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
 }
 
-var (
-	file_src_transport_grpc_proto_visit_proto_rawDescOnce sync.Once
-	file_src_transport_grpc_proto_visit_proto_rawDescData = file_src_transport_grpc_proto_visit_proto_rawDesc
-)
+func (x *VisitBatchGetRequest) Reset() {
+	*x = VisitBatchGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_src_transport_grpc_proto_visit_proto_rawDescGZIP() []byte {
-	file_src_transport_grpc_proto_visit_proto_rawDescOnce.Do(func() {
-		file_src_transport_grpc_proto_visit_proto_rawDescData = protoimpl.X.CompressGZIP(file_src_transport_grpc_proto_visit_proto_rawDescData)
-	})
-	return file_src_transport_grpc_proto_visit_proto_rawDescData
+func (x *VisitBatchGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_src_transport_grpc_proto_visit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_src_transport_grpc_proto_visit_proto_goTypes = []interface{}{
-	(*VisitRequest)(nil),          // 0: pb.VisitRequest
-	(*VisitResponse)(nil),         // 1: pb.VisitResponse
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(*ID)(nil),                    // 3: pb.ID
+func (*VisitBatchGetRequest) ProtoMessage() {}
+
+func (x *VisitBatchGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_src_transport_grpc_proto_visit_proto_depIdxs = []int32{
-	2, // 0: pb.VisitResponse.CreatedAt:type_name -> google.protobuf.Timestamp
-	2, // 1: pb.VisitResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
-	3, // 2: pb.Visit.Get:input_type -> pb.ID
-	0, // 3: pb.Visit.Set:input_type -> pb.VisitRequest
-	1, // 4: pb.Visit.Get:output_type -> pb.VisitResponse
-	1, // 5: pb.Visit.Set:output_type -> pb.VisitResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use VisitBatchGetRequest.ProtoReflect.Descriptor instead.
+func (*VisitBatchGetRequest) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{4}
 }
 
-func init() { file_src_transport_grpc_proto_visit_proto_init() }
-func file_src_transport_grpc_proto_visit_proto_init() {
-	if File_src_transport_grpc_proto_visit_proto != nil {
-		return
+func (x *VisitBatchGetRequest) GetIDs() []uint32 {
+	if x != nil {
+		return x.IDs
 	}
-	file_src_transport_grpc_proto_generics_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_src_transport_grpc_proto_visit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VisitRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+type VisitBatchGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Visits   []*VisitResponse `protobuf:"bytes,1,rep,name=Visits,proto3" json:"Visits,omitempty"`
+	NotFound []uint32         `protobuf:"varint,2,rep,packed,name=NotFound,proto3" json:"NotFound,omitempty"`
+}
+
+func (x *VisitBatchGetResponse) Reset() {
+	*x = VisitBatchGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitBatchGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitBatchGetResponse) ProtoMessage() {}
+
+func (x *VisitBatchGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_src_transport_grpc_proto_visit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VisitResponse); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitBatchGetResponse.ProtoReflect.Descriptor instead.
+func (*VisitBatchGetResponse) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VisitBatchGetResponse) GetVisits() []*VisitResponse {
+	if x != nil {
+		return x.Visits
+	}
+	return nil
+}
+
+func (x *VisitBatchGetResponse) GetNotFound() []uint32 {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+// Validated per item, see VisitBatchSetResult
+type VisitBatchSetItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	FirstName string `protobuf:"bytes,2,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
+	LastName  string `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
+	// Expected version, optional
+	Version uint32 `protobuf:"varint,4,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (x *VisitBatchSetItem) Reset() {
+	*x = VisitBatchSetItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitBatchSetItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitBatchSetItem) ProtoMessage() {}
+
+func (x *VisitBatchSetItem) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitBatchSetItem.ProtoReflect.Descriptor instead.
+func (*VisitBatchSetItem) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VisitBatchSetItem) GetID() uint32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *VisitBatchSetItem) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *VisitBatchSetItem) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *VisitBatchSetItem) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type VisitBatchSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Visits []*VisitBatchSetItem `protobuf:"bytes,1,rep,name=Visits,proto3" json:"Visits,omitempty"`
+	Atomic bool                 `protobuf:"varint,2,opt,name=Atomic,proto3" json:"Atomic,omitempty"`
+}
+
+func (x *VisitBatchSetRequest) Reset() {
+	*x = VisitBatchSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitBatchSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitBatchSetRequest) ProtoMessage() {}
+
+func (x *VisitBatchSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitBatchSetRequest.ProtoReflect.Descriptor instead.
+func (*VisitBatchSetRequest) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VisitBatchSetRequest) GetVisits() []*VisitBatchSetItem {
+	if x != nil {
+		return x.Visits
+	}
+	return nil
+}
+
+func (x *VisitBatchSetRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type VisitBatchSetResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Set on success
+	Visit *VisitResponse `protobuf:"bytes,1,opt,name=Visit,proto3" json:"Visit,omitempty"`
+	// Set on failure, e.g INVALID_ARGUMENT with BadRequest details
+	Error *status.Status `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *VisitBatchSetResult) Reset() {
+	*x = VisitBatchSetResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitBatchSetResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitBatchSetResult) ProtoMessage() {}
+
+func (x *VisitBatchSetResult) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitBatchSetResult.ProtoReflect.Descriptor instead.
+func (*VisitBatchSetResult) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VisitBatchSetResult) GetVisit() *VisitResponse {
+	if x != nil {
+		return x.Visit
+	}
+	return nil
+}
+
+func (x *VisitBatchSetResult) GetError() *status.Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type VisitBatchSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In the same order as the request
+	Results []*VisitBatchSetResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+}
+
+func (x *VisitBatchSetResponse) Reset() {
+	*x = VisitBatchSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitBatchSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitBatchSetResponse) ProtoMessage() {}
+
+func (x *VisitBatchSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitBatchSetResponse.ProtoReflect.Descriptor instead.
+func (*VisitBatchSetResponse) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VisitBatchSetResponse) GetResults() []*VisitBatchSetResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type VisitWatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the last event received, 0 for new events only
+	LastEventID uint64 `protobuf:"varint,1,opt,name=LastEventID,proto3" json:"LastEventID,omitempty"`
+}
+
+func (x *VisitWatchRequest) Reset() {
+	*x = VisitWatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitWatchRequest) ProtoMessage() {}
+
+func (x *VisitWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitWatchRequest.ProtoReflect.Descriptor instead.
+func (*VisitWatchRequest) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VisitWatchRequest) GetLastEventID() uint64 {
+	if x != nil {
+		return x.LastEventID
+	}
+	return 0
+}
+
+type VisitEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID   uint64               `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Type VisitEvent_EventType `protobuf:"varint,2,opt,name=Type,proto3,enum=pb.VisitEvent_EventType" json:"Type,omitempty"`
+	// Only the ID is set for deleted visits
+	Visit     *VisitResponse         `protobuf:"bytes,3,opt,name=Visit,proto3" json:"Visit,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *VisitEvent) Reset() {
+	*x = VisitEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitEvent) ProtoMessage() {}
+
+func (x *VisitEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitEvent.ProtoReflect.Descriptor instead.
+func (*VisitEvent) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VisitEvent) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *VisitEvent) GetType() VisitEvent_EventType {
+	if x != nil {
+		return x.Type
+	}
+	return VisitEvent_UNKNOWN
+}
+
+func (x *VisitEvent) GetVisit() *VisitResponse {
+	if x != nil {
+		return x.Visit
+	}
+	return nil
+}
+
+func (x *VisitEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type VisitListAuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VisitID uint32 `protobuf:"varint,1,opt,name=VisitID,proto3" json:"VisitID,omitempty"`
+}
+
+func (x *VisitListAuditRequest) Reset() {
+	*x = VisitListAuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitListAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitListAuditRequest) ProtoMessage() {}
+
+func (x *VisitListAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitListAuditRequest.ProtoReflect.Descriptor instead.
+func (*VisitListAuditRequest) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VisitListAuditRequest) GetVisitID() uint32 {
+	if x != nil {
+		return x.VisitID
+	}
+	return 0
+}
+
+type VisitAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      uint64                `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	VisitID uint32                `protobuf:"varint,2,opt,name=VisitID,proto3" json:"VisitID,omitempty"`
+	Action  VisitAudit_ActionType `protobuf:"varint,3,opt,name=Action,proto3,enum=pb.VisitAudit_ActionType" json:"Action,omitempty"`
+	// Who made the change, as sent in the x-principal metadata
+	Principal string `protobuf:"bytes,4,opt,name=Principal,proto3" json:"Principal,omitempty"`
+	RequestID string `protobuf:"bytes,5,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	TraceID   string `protobuf:"bytes,6,opt,name=TraceID,proto3" json:"TraceID,omitempty"`
+	// Changed fields only. OldValues is empty on creation and NewValues on deletion, both once the visit is purged
+	OldValues *structpb.Struct       `protobuf:"bytes,7,opt,name=OldValues,proto3" json:"OldValues,omitempty"`
+	NewValues *structpb.Struct       `protobuf:"bytes,8,opt,name=NewValues,proto3" json:"NewValues,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *VisitAudit) Reset() {
+	*x = VisitAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitAudit) ProtoMessage() {}
+
+func (x *VisitAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitAudit.ProtoReflect.Descriptor instead.
+func (*VisitAudit) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VisitAudit) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *VisitAudit) GetVisitID() uint32 {
+	if x != nil {
+		return x.VisitID
+	}
+	return 0
+}
+
+func (x *VisitAudit) GetAction() VisitAudit_ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return VisitAudit_UNKNOWN
+}
+
+func (x *VisitAudit) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *VisitAudit) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *VisitAudit) GetTraceID() string {
+	if x != nil {
+		return x.TraceID
+	}
+	return ""
+}
+
+func (x *VisitAudit) GetOldValues() *structpb.Struct {
+	if x != nil {
+		return x.OldValues
+	}
+	return nil
+}
+
+func (x *VisitAudit) GetNewValues() *structpb.Struct {
+	if x != nil {
+		return x.NewValues
+	}
+	return nil
+}
+
+func (x *VisitAudit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type VisitListAuditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Audits []*VisitAudit `protobuf:"bytes,1,rep,name=Audits,proto3" json:"Audits,omitempty"`
+}
+
+func (x *VisitListAuditResponse) Reset() {
+	*x = VisitListAuditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitListAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitListAuditResponse) ProtoMessage() {}
+
+func (x *VisitListAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitListAuditResponse.ProtoReflect.Descriptor instead.
+func (*VisitListAuditResponse) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VisitListAuditResponse) GetAudits() []*VisitAudit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+type VisitDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID uint32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *VisitDeleteRequest) Reset() {
+	*x = VisitDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitDeleteRequest) ProtoMessage() {}
+
+func (x *VisitDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitDeleteRequest.ProtoReflect.Descriptor instead.
+func (*VisitDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VisitDeleteRequest) GetID() uint32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type VisitListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize uint32 `protobuf:"varint,1,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	// Opaque cursor, as returned by the previous page's NextPageToken
+	PageToken       string                 `protobuf:"bytes,2,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	FirstNamePrefix string                 `protobuf:"bytes,3,opt,name=FirstNamePrefix,proto3" json:"FirstNamePrefix,omitempty"`
+	LastNamePrefix  string                 `protobuf:"bytes,4,opt,name=LastNamePrefix,proto3" json:"LastNamePrefix,omitempty"`
+	CreatedAfter    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=CreatedAfter,proto3" json:"CreatedAfter,omitempty"`
+	CreatedBefore   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedBefore,proto3" json:"CreatedBefore,omitempty"`
+	OrderBy         string                 `protobuf:"bytes,7,opt,name=OrderBy,proto3" json:"OrderBy,omitempty"`
+	Descending      bool                   `protobuf:"varint,8,opt,name=Descending,proto3" json:"Descending,omitempty"`
+}
+
+func (x *VisitListRequest) Reset() {
+	*x = VisitListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitListRequest) ProtoMessage() {}
+
+func (x *VisitListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitListRequest.ProtoReflect.Descriptor instead.
+func (*VisitListRequest) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VisitListRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *VisitListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *VisitListRequest) GetFirstNamePrefix() string {
+	if x != nil {
+		return x.FirstNamePrefix
+	}
+	return ""
+}
+
+func (x *VisitListRequest) GetLastNamePrefix() string {
+	if x != nil {
+		return x.LastNamePrefix
+	}
+	return ""
+}
+
+func (x *VisitListRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *VisitListRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *VisitListRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *VisitListRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type VisitListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Visits []*VisitResponse `protobuf:"bytes,1,rep,name=Visits,proto3" json:"Visits,omitempty"`
+	// Empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+}
+
+func (x *VisitListResponse) Reset() {
+	*x = VisitListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VisitListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitListResponse) ProtoMessage() {}
+
+func (x *VisitListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_transport_grpc_proto_visit_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitListResponse.ProtoReflect.Descriptor instead.
+func (*VisitListResponse) Descriptor() ([]byte, []int) {
+	return file_src_transport_grpc_proto_visit_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VisitListResponse) GetVisits() []*VisitResponse {
+	if x != nil {
+		return x.Visits
+	}
+	return nil
+}
+
+func (x *VisitListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_src_transport_grpc_proto_visit_proto protoreflect.FileDescriptor
+
+var file_src_transport_grpc_proto_visit_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x73, 0x72, 0x63, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x27, 0x73, 0x72, 0x63, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x73, 0x72, 0x63,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x60, 0x0a, 0x0c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x02,
+	0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x4c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x02, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x79, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x74, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x17, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x56, 0x69, 0x73, 0x69, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x56, 0x69, 0x73, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73,
+	0x69, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0xe7, 0x01, 0x0a, 0x0d, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x34,
+	0x0a, 0x14, 0x56, 0x69, 0x73, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0d, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64, 0x52,
+	0x03, 0x49, 0x44, 0x73, 0x22, 0x5e, 0x0a, 0x15, 0x56, 0x69, 0x73, 0x69, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x06, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x06, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x46,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x4e, 0x6f, 0x74, 0x46,
+	0x6f, 0x75, 0x6e, 0x64, 0x22, 0x77, 0x0a, 0x11, 0x56, 0x69, 0x73, 0x69, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x69, 0x0a,
+	0x14, 0x56, 0x69, 0x73, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0a, 0xfa, 0x42,
+	0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64, 0x52, 0x06, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x22, 0x68, 0x0a, 0x13, 0x56, 0x69, 0x73, 0x69,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x4a, 0x0a, 0x15, 0x56, 0x69, 0x73, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x35,
+	0x0a, 0x11, 0x56, 0x69, 0x73, 0x69, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0xee, 0x01, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3f, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x22, 0x3a, 0x0a, 0x15, 0x56, 0x69, 0x73, 0x69, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x07, 0x56, 0x69, 0x73, 0x69, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x56, 0x69, 0x73, 0x69, 0x74,
+	0x49, 0x44, 0x22, 0xb5, 0x03, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x69, 0x73, 0x69, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x56, 0x69, 0x73, 0x69, 0x74, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x06, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x09, 0x4f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x09, 0x4f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x4e,
+	0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x09, 0x4e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4c, 0x0a, 0x0a,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a,
+	0x0a, 0x06, 0x50, 0x55, 0x52, 0x47, 0x45, 0x44, 0x10, 0x04, 0x22, 0x40, 0x0a, 0x16, 0x56, 0x69,
+	0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x52, 0x06, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x22, 0x33, 0x0a, 0x12,
+	0x56, 0x69, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x02, 0x49, 0x44, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x22, 0xa7, 0x03, 0x0a, 0x10, 0x56, 0x69, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18,
+	0x64, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x0f, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xfe, 0x01, 0x52, 0x0f, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x30, 0x0a,
+	0x0e, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xfe, 0x01, 0x52,
+	0x0e, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x3e, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x40, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x48, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2e, 0xfa, 0x42, 0x2b, 0x72, 0x29, 0x52, 0x00, 0x52, 0x02, 0x69, 0x64, 0x52,
+	0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x44,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x64, 0x0a, 0x11, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x06, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x06, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x32, 0xd6, 0x08, 0x0a, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x58, 0x0a, 0x03, 0x47,
+	0x65, 0x74, 0x12, 0x06, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e,
+	0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0xc2,
+	0xf3, 0x18, 0x1c, 0x12, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x74, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x1a,
+	0x06, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x1a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74,
+	0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x12, 0x5c, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0xc2, 0xf3, 0x18, 0x15, 0x12, 0x0b, 0x76, 0x69, 0x73, 0x69,
+	0x74, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x1a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74,
+	0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0xc2, 0xf3, 0x18, 0x15, 0x12, 0x0b,
+	0x76, 0x69, 0x73, 0x69, 0x74, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x1a, 0x06, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x32, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x76,
+	0x69, 0x73, 0x69, 0x74, 0x2f, 0x7b, 0x56, 0x69, 0x73, 0x69, 0x74, 0x2e, 0x49, 0x44, 0x7d, 0x3a,
+	0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x66, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0xc2, 0xf3, 0x18,
+	0x1c, 0x12, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x74, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x1a, 0x06, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x1a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x12, 0x7b,
+	0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x56, 0x69, 0x73, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3a, 0xc2, 0xf3, 0x18, 0x1c, 0x12, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x74, 0x2e, 0x72, 0x65, 0x61,
+	0x64, 0x1a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x1a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x73,
+	0x69, 0x74, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x12, 0x77, 0x0a, 0x08, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73,
+	0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0xc2, 0xf3,
+	0x18, 0x15, 0x12, 0x0b, 0x76, 0x69, 0x73, 0x69, 0x74, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x1a,
+	0x06, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f,
+	0x76, 0x31, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x74, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15, 0x2e,
+	0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x37, 0xc2, 0xf3, 0x18, 0x1c, 0x12, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x74, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x1a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x1a, 0x06,
+	0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76,
+	0x31, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12,
+	0x7f, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73,
+	0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3b, 0xc2, 0xf3, 0x18, 0x16, 0x12, 0x0b, 0x76, 0x69, 0x73, 0x69, 0x74,
+	0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x1a, 0x07, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74,
+	0x2f, 0x7b, 0x56, 0x69, 0x73, 0x69, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x12, 0x69, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e,
+	0x56, 0x69, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2f, 0xc2, 0xf3, 0x18, 0x15,
+	0x12, 0x0b, 0x76, 0x69, 0x73, 0x69, 0x74, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x1a, 0x06, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x2a, 0x0e, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x12, 0x6d, 0x0a, 0x05, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x34, 0xc2, 0xf3, 0x18, 0x14, 0x12, 0x0b, 0x76, 0x69, 0x73, 0x69,
+	0x74, 0x2e, 0x70, 0x75, 0x72, 0x67, 0x65, 0x1a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x2f,
+	0x7b, 0x49, 0x44, 0x7d, 0x3a, 0x70, 0x75, 0x72, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_src_transport_grpc_proto_visit_proto_rawDescOnce sync.Once
+	file_src_transport_grpc_proto_visit_proto_rawDescData = file_src_transport_grpc_proto_visit_proto_rawDesc
+)
+
+func file_src_transport_grpc_proto_visit_proto_rawDescGZIP() []byte {
+	file_src_transport_grpc_proto_visit_proto_rawDescOnce.Do(func() {
+		file_src_transport_grpc_proto_visit_proto_rawDescData = protoimpl.X.CompressGZIP(file_src_transport_grpc_proto_visit_proto_rawDescData)
+	})
+	return file_src_transport_grpc_proto_visit_proto_rawDescData
+}
+
+var file_src_transport_grpc_proto_visit_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_src_transport_grpc_proto_visit_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_src_transport_grpc_proto_visit_proto_goTypes = []interface{}{
+	(VisitEvent_EventType)(0),      // 0: pb.VisitEvent.EventType
+	(VisitAudit_ActionType)(0),     // 1: pb.VisitAudit.ActionType
+	(*VisitRequest)(nil),           // 2: pb.VisitRequest
+	(*VisitPatch)(nil),             // 3: pb.VisitPatch
+	(*VisitUpdateRequest)(nil),     // 4: pb.VisitUpdateRequest
+	(*VisitResponse)(nil),          // 5: pb.VisitResponse
+	(*VisitBatchGetRequest)(nil),   // 6: pb.VisitBatchGetRequest
+	(*VisitBatchGetResponse)(nil),  // 7: pb.VisitBatchGetResponse
+	(*VisitBatchSetItem)(nil),      // 8: pb.VisitBatchSetItem
+	(*VisitBatchSetRequest)(nil),   // 9: pb.VisitBatchSetRequest
+	(*VisitBatchSetResult)(nil),    // 10: pb.VisitBatchSetResult
+	(*VisitBatchSetResponse)(nil),  // 11: pb.VisitBatchSetResponse
+	(*VisitWatchRequest)(nil),      // 12: pb.VisitWatchRequest
+	(*VisitEvent)(nil),             // 13: pb.VisitEvent
+	(*VisitListAuditRequest)(nil),  // 14: pb.VisitListAuditRequest
+	(*VisitAudit)(nil),             // 15: pb.VisitAudit
+	(*VisitListAuditResponse)(nil), // 16: pb.VisitListAuditResponse
+	(*VisitDeleteRequest)(nil),     // 17: pb.VisitDeleteRequest
+	(*VisitListRequest)(nil),       // 18: pb.VisitListRequest
+	(*VisitListResponse)(nil),      // 19: pb.VisitListResponse
+	(*fieldmaskpb.FieldMask)(nil),  // 20: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),  // 21: google.protobuf.Timestamp
+	(*status.Status)(nil),          // 22: google.rpc.Status
+	(*structpb.Struct)(nil),        // 23: google.protobuf.Struct
+	(*ID)(nil),                     // 24: pb.ID
+	(*emptypb.Empty)(nil),          // 25: google.protobuf.Empty
+}
+var file_src_transport_grpc_proto_visit_proto_depIdxs = []int32{
+	3,  // 0: pb.VisitUpdateRequest.Visit:type_name -> pb.VisitPatch
+	20, // 1: pb.VisitUpdateRequest.UpdateMask:type_name -> google.protobuf.FieldMask
+	21, // 2: pb.VisitResponse.CreatedAt:type_name -> google.protobuf.Timestamp
+	21, // 3: pb.VisitResponse.UpdatedAt:type_name -> google.protobuf.Timestamp
+	5,  // 4: pb.VisitBatchGetResponse.Visits:type_name -> pb.VisitResponse
+	8,  // 5: pb.VisitBatchSetRequest.Visits:type_name -> pb.VisitBatchSetItem
+	5,  // 6: pb.VisitBatchSetResult.Visit:type_name -> pb.VisitResponse
+	22, // 7: pb.VisitBatchSetResult.Error:type_name -> google.rpc.Status
+	10, // 8: pb.VisitBatchSetResponse.Results:type_name -> pb.VisitBatchSetResult
+	0,  // 9: pb.VisitEvent.Type:type_name -> pb.VisitEvent.EventType
+	5,  // 10: pb.VisitEvent.Visit:type_name -> pb.VisitResponse
+	21, // 11: pb.VisitEvent.CreatedAt:type_name -> google.protobuf.Timestamp
+	1,  // 12: pb.VisitAudit.Action:type_name -> pb.VisitAudit.ActionType
+	23, // 13: pb.VisitAudit.OldValues:type_name -> google.protobuf.Struct
+	23, // 14: pb.VisitAudit.NewValues:type_name -> google.protobuf.Struct
+	21, // 15: pb.VisitAudit.CreatedAt:type_name -> google.protobuf.Timestamp
+	15, // 16: pb.VisitListAuditResponse.Audits:type_name -> pb.VisitAudit
+	21, // 17: pb.VisitListRequest.CreatedAfter:type_name -> google.protobuf.Timestamp
+	21, // 18: pb.VisitListRequest.CreatedBefore:type_name -> google.protobuf.Timestamp
+	5,  // 19: pb.VisitListResponse.Visits:type_name -> pb.VisitResponse
+	24, // 20: pb.Visit.Get:input_type -> pb.ID
+	2,  // 21: pb.Visit.Create:input_type -> pb.VisitRequest
+	4,  // 22: pb.Visit.Update:input_type -> pb.VisitUpdateRequest
+	18, // 23: pb.Visit.List:input_type -> pb.VisitListRequest
+	6,  // 24: pb.Visit.BatchGet:input_type -> pb.VisitBatchGetRequest
+	9,  // 25: pb.Visit.BatchSet:input_type -> pb.VisitBatchSetRequest
+	12, // 26: pb.Visit.Watch:input_type -> pb.VisitWatchRequest
+	14, // 27: pb.Visit.ListAudit:input_type -> pb.VisitListAuditRequest
+	17, // 28: pb.Visit.Delete:input_type -> pb.VisitDeleteRequest
+	17, // 29: pb.Visit.Purge:input_type -> pb.VisitDeleteRequest
+	5,  // 30: pb.Visit.Get:output_type -> pb.VisitResponse
+	5,  // 31: pb.Visit.Create:output_type -> pb.VisitResponse
+	5,  // 32: pb.Visit.Update:output_type -> pb.VisitResponse
+	19, // 33: pb.Visit.List:output_type -> pb.VisitListResponse
+	7,  // 34: pb.Visit.BatchGet:output_type -> pb.VisitBatchGetResponse
+	11, // 35: pb.Visit.BatchSet:output_type -> pb.VisitBatchSetResponse
+	13, // 36: pb.Visit.Watch:output_type -> pb.VisitEvent
+	16, // 37: pb.Visit.ListAudit:output_type -> pb.VisitListAuditResponse
+	25, // 38: pb.Visit.Delete:output_type -> google.protobuf.Empty
+	25, // 39: pb.Visit.Purge:output_type -> google.protobuf.Empty
+	30, // [30:40] is the sub-list for method output_type
+	20, // [20:30] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_src_transport_grpc_proto_visit_proto_init() }
+func file_src_transport_grpc_proto_visit_proto_init() {
+	if File_src_transport_grpc_proto_visit_proto != nil {
+		return
+	}
+	file_src_transport_grpc_proto_generics_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_src_transport_grpc_proto_visit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitPatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitBatchGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitBatchGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitBatchSetItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitBatchSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitBatchSetResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitBatchSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitListAuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitAudit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitListAuditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_src_transport_grpc_proto_visit_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -284,13 +1854,14 @@ func file_src_transport_grpc_proto_visit_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_src_transport_grpc_proto_visit_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_src_transport_grpc_proto_visit_proto_goTypes,
 		DependencyIndexes: file_src_transport_grpc_proto_visit_proto_depIdxs,
+		EnumInfos:         file_src_transport_grpc_proto_visit_proto_enumTypes,
 		MessageInfos:      file_src_transport_grpc_proto_visit_proto_msgTypes,
 	}.Build()
 	File_src_transport_grpc_proto_visit_proto = out.File
@@ -41,13 +41,6 @@ func (m *VisitRequest) Validate() error {
 		return nil
 	}
 
-	if m.GetID() < 0 {
-		return VisitRequestValidationError{
-			field:  "ID",
-			reason: "value must be greater than or equal to 0",
-		}
-	}
-
 	if utf8.RuneCountInString(m.GetFirstName()) < 2 {
 		return VisitRequestValidationError{
 			field:  "FirstName",
@@ -119,6 +112,177 @@ var _ interface {
 	ErrorName() string
 } = VisitRequestValidationError{}
 
+// Validate checks the field values on VisitPatch with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *VisitPatch) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetID() <= 0 {
+		return VisitPatchValidationError{
+			field:  "ID",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for FirstName
+
+	// no validation rules for LastName
+
+	// no validation rules for Version
+
+	return nil
+}
+
+// VisitPatchValidationError is the validation error returned by
+// VisitPatch.Validate if the designated constraints aren't met.
+type VisitPatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitPatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitPatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitPatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitPatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitPatchValidationError) ErrorName() string { return "VisitPatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VisitPatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitPatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitPatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitPatchValidationError{}
+
+// Validate checks the field values on VisitUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *VisitUpdateRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetVisit() == nil {
+		return VisitUpdateRequestValidationError{
+			field:  "Visit",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetVisit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitUpdateRequestValidationError{
+				field:  "Visit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitUpdateRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// VisitUpdateRequestValidationError is the validation error returned by
+// VisitUpdateRequest.Validate if the designated constraints aren't met.
+type VisitUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitUpdateRequestValidationError) ErrorName() string {
+	return "VisitUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitUpdateRequestValidationError{}
+
 // Validate checks the field values on VisitResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
@@ -153,6 +317,8 @@ func (m *VisitResponse) Validate() error {
 		}
 	}
 
+	// no validation rules for Version
+
 	return nil
 }
 
@@ -209,3 +375,1196 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = VisitResponseValidationError{}
+
+// Validate checks the field values on VisitBatchGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *VisitBatchGetRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := len(m.GetIDs()); l < 1 || l > 100 {
+		return VisitBatchGetRequestValidationError{
+			field:  "IDs",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+	}
+
+	return nil
+}
+
+// VisitBatchGetRequestValidationError is the validation error returned by
+// VisitBatchGetRequest.Validate if the designated constraints aren't met.
+type VisitBatchGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitBatchGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitBatchGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitBatchGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitBatchGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitBatchGetRequestValidationError) ErrorName() string {
+	return "VisitBatchGetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitBatchGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitBatchGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitBatchGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitBatchGetRequestValidationError{}
+
+// Validate checks the field values on VisitBatchGetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *VisitBatchGetResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetVisits() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VisitBatchGetResponseValidationError{
+					field:  fmt.Sprintf("Visits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// VisitBatchGetResponseValidationError is the validation error returned by
+// VisitBatchGetResponse.Validate if the designated constraints aren't met.
+type VisitBatchGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitBatchGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitBatchGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitBatchGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitBatchGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitBatchGetResponseValidationError) ErrorName() string {
+	return "VisitBatchGetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitBatchGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitBatchGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitBatchGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitBatchGetResponseValidationError{}
+
+// Validate checks the field values on VisitBatchSetItem with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *VisitBatchSetItem) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ID
+
+	// no validation rules for FirstName
+
+	// no validation rules for LastName
+
+	// no validation rules for Version
+
+	return nil
+}
+
+// VisitBatchSetItemValidationError is the validation error returned by
+// VisitBatchSetItem.Validate if the designated constraints aren't met.
+type VisitBatchSetItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitBatchSetItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitBatchSetItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitBatchSetItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitBatchSetItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitBatchSetItemValidationError) ErrorName() string {
+	return "VisitBatchSetItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitBatchSetItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitBatchSetItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitBatchSetItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitBatchSetItemValidationError{}
+
+// Validate checks the field values on VisitBatchSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *VisitBatchSetRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := len(m.GetVisits()); l < 1 || l > 100 {
+		return VisitBatchSetRequestValidationError{
+			field:  "Visits",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+	}
+
+	for idx, item := range m.GetVisits() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VisitBatchSetRequestValidationError{
+					field:  fmt.Sprintf("Visits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Atomic
+
+	return nil
+}
+
+// VisitBatchSetRequestValidationError is the validation error returned by
+// VisitBatchSetRequest.Validate if the designated constraints aren't met.
+type VisitBatchSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitBatchSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitBatchSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitBatchSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitBatchSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitBatchSetRequestValidationError) ErrorName() string {
+	return "VisitBatchSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitBatchSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitBatchSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitBatchSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitBatchSetRequestValidationError{}
+
+// Validate checks the field values on VisitBatchSetResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *VisitBatchSetResult) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetVisit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitBatchSetResultValidationError{
+				field:  "Visit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitBatchSetResultValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// VisitBatchSetResultValidationError is the validation error returned by
+// VisitBatchSetResult.Validate if the designated constraints aren't met.
+type VisitBatchSetResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitBatchSetResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitBatchSetResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitBatchSetResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitBatchSetResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitBatchSetResultValidationError) ErrorName() string {
+	return "VisitBatchSetResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitBatchSetResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitBatchSetResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitBatchSetResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitBatchSetResultValidationError{}
+
+// Validate checks the field values on VisitBatchSetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *VisitBatchSetResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VisitBatchSetResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// VisitBatchSetResponseValidationError is the validation error returned by
+// VisitBatchSetResponse.Validate if the designated constraints aren't met.
+type VisitBatchSetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitBatchSetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitBatchSetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitBatchSetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitBatchSetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitBatchSetResponseValidationError) ErrorName() string {
+	return "VisitBatchSetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitBatchSetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitBatchSetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitBatchSetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitBatchSetResponseValidationError{}
+
+// Validate checks the field values on VisitWatchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *VisitWatchRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for LastEventID
+
+	return nil
+}
+
+// VisitWatchRequestValidationError is the validation error returned by
+// VisitWatchRequest.Validate if the designated constraints aren't met.
+type VisitWatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitWatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitWatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitWatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitWatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitWatchRequestValidationError) ErrorName() string {
+	return "VisitWatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitWatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitWatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitWatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitWatchRequestValidationError{}
+
+// Validate checks the field values on VisitEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *VisitEvent) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ID
+
+	// no validation rules for Type
+
+	if v, ok := interface{}(m.GetVisit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitEventValidationError{
+				field:  "Visit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// VisitEventValidationError is the validation error returned by
+// VisitEvent.Validate if the designated constraints aren't met.
+type VisitEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitEventValidationError) ErrorName() string { return "VisitEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VisitEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitEventValidationError{}
+
+// Validate checks the field values on VisitListAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *VisitListAuditRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetVisitID() <= 0 {
+		return VisitListAuditRequestValidationError{
+			field:  "VisitID",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// VisitListAuditRequestValidationError is the validation error returned by
+// VisitListAuditRequest.Validate if the designated constraints aren't met.
+type VisitListAuditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitListAuditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitListAuditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitListAuditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitListAuditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitListAuditRequestValidationError) ErrorName() string {
+	return "VisitListAuditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitListAuditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitListAuditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitListAuditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitListAuditRequestValidationError{}
+
+// Validate checks the field values on VisitAudit with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *VisitAudit) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ID
+
+	// no validation rules for VisitID
+
+	// no validation rules for Action
+
+	// no validation rules for Principal
+
+	// no validation rules for RequestID
+
+	// no validation rules for TraceID
+
+	if v, ok := interface{}(m.GetOldValues()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitAuditValidationError{
+				field:  "OldValues",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetNewValues()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitAuditValidationError{
+				field:  "NewValues",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitAuditValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// VisitAuditValidationError is the validation error returned by
+// VisitAudit.Validate if the designated constraints aren't met.
+type VisitAuditValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitAuditValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitAuditValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitAuditValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitAuditValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitAuditValidationError) ErrorName() string { return "VisitAuditValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VisitAuditValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitAudit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitAuditValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitAuditValidationError{}
+
+// Validate checks the field values on VisitListAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *VisitListAuditResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetAudits() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VisitListAuditResponseValidationError{
+					field:  fmt.Sprintf("Audits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// VisitListAuditResponseValidationError is the validation error returned by
+// VisitListAuditResponse.Validate if the designated constraints aren't met.
+type VisitListAuditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitListAuditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitListAuditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitListAuditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitListAuditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitListAuditResponseValidationError) ErrorName() string {
+	return "VisitListAuditResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitListAuditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitListAuditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitListAuditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitListAuditResponseValidationError{}
+
+// Validate checks the field values on VisitDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *VisitDeleteRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetID() <= 0 {
+		return VisitDeleteRequestValidationError{
+			field:  "ID",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// VisitDeleteRequestValidationError is the validation error returned by
+// VisitDeleteRequest.Validate if the designated constraints aren't met.
+type VisitDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitDeleteRequestValidationError) ErrorName() string {
+	return "VisitDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitDeleteRequestValidationError{}
+
+// Validate checks the field values on VisitListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *VisitListRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetPageSize() > 100 {
+		return VisitListRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+	}
+
+	// no validation rules for PageToken
+
+	if utf8.RuneCountInString(m.GetFirstNamePrefix()) > 254 {
+		return VisitListRequestValidationError{
+			field:  "FirstNamePrefix",
+			reason: "value length must be at most 254 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLastNamePrefix()) > 254 {
+		return VisitListRequestValidationError{
+			field:  "LastNamePrefix",
+			reason: "value length must be at most 254 runes",
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitListRequestValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VisitListRequestValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _VisitListRequest_OrderBy_InLookup[m.GetOrderBy()]; !ok {
+		return VisitListRequestValidationError{
+			field:  "OrderBy",
+			reason: "value must be in list [ id first_name last_name created_at]",
+		}
+	}
+
+	// no validation rules for Descending
+
+	return nil
+}
+
+// VisitListRequestValidationError is the validation error returned by
+// VisitListRequest.Validate if the designated constraints aren't met.
+type VisitListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitListRequestValidationError) ErrorName() string { return "VisitListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VisitListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitListRequestValidationError{}
+
+var _VisitListRequest_OrderBy_InLookup = map[string]struct{}{
+	"":           {},
+	"id":         {},
+	"first_name": {},
+	"last_name":  {},
+	"created_at": {},
+}
+
+// Validate checks the field values on VisitListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *VisitListResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetVisits() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VisitListResponseValidationError{
+					field:  fmt.Sprintf("Visits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// VisitListResponseValidationError is the validation error returned by
+// VisitListResponse.Validate if the designated constraints aren't met.
+type VisitListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VisitListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VisitListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VisitListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VisitListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VisitListResponseValidationError) ErrorName() string {
+	return "VisitListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VisitListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVisitListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VisitListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VisitListResponseValidationError{}
@@ -7,6 +7,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,8 +21,29 @@ const _ = grpc.SupportPackageIsVersion7
 type VisitClient interface {
 	// Simple return the visit id
 	Get(ctx context.Context, in *ID, opts ...grpc.CallOption) (*VisitResponse, error)
-	// Update/Create a device
-	Set(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*VisitResponse, error)
+	// Create a visit
+	Create(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*VisitResponse, error)
+	// Update a visit, only the fields listed in UpdateMask are written.
+	// Over HTTP the mask defaults to the fields present in the body.
+	// Set Version (or the If-Match header) to fail with FAILED_PRECONDITION (412 over HTTP) if the visit was modified in the meantime
+	Update(ctx context.Context, in *VisitUpdateRequest, opts ...grpc.CallOption) (*VisitResponse, error)
+	// List visits, supports filtering, ordering and cursor based pagination
+	List(ctx context.Context, in *VisitListRequest, opts ...grpc.CallOption) (*VisitListResponse, error)
+	// Get multiple visits at once, missing visits are listed in NotFound
+	BatchGet(ctx context.Context, in *VisitBatchGetRequest, opts ...grpc.CallOption) (*VisitBatchGetResponse, error)
+	// Create (no ID) or update multiple visits at once. Each visit reports its own result,
+	// unless Atomic is set; then the whole batch fails on the first error
+	BatchSet(ctx context.Context, in *VisitBatchSetRequest, opts ...grpc.CallOption) (*VisitBatchSetResponse, error)
+	// Stream visit changes as they happen. Set LastEventID to resume after a disconnect.
+	// Events are kept in-process: only changes made through the same server instance are streamed,
+	// and event IDs and history are per instance, resume on the instance that issued them (single instance deployments)
+	Watch(ctx context.Context, in *VisitWatchRequest, opts ...grpc.CallOption) (Visit_WatchClient, error)
+	// List the changes of a visit, oldest first. Deleted and purged visits keep their history
+	ListAudit(ctx context.Context, in *VisitListAuditRequest, opts ...grpc.CallOption) (*VisitListAuditResponse, error)
+	// Soft delete a visit, it's no longer returned but kept in the database
+	Delete(ctx context.Context, in *VisitDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Remove a visit permanently, including a soft deleted one (e.g GDPR requests)
+	Purge(ctx context.Context, in *VisitDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type visitClient struct {
@@ -41,9 +63,104 @@ func (c *visitClient) Get(ctx context.Context, in *ID, opts ...grpc.CallOption)
 	return out, nil
 }
 
-func (c *visitClient) Set(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*VisitResponse, error) {
+func (c *visitClient) Create(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*VisitResponse, error) {
 	out := new(VisitResponse)
-	err := c.cc.Invoke(ctx, "/pb.Visit/Set", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Visit/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visitClient) Update(ctx context.Context, in *VisitUpdateRequest, opts ...grpc.CallOption) (*VisitResponse, error) {
+	out := new(VisitResponse)
+	err := c.cc.Invoke(ctx, "/pb.Visit/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visitClient) List(ctx context.Context, in *VisitListRequest, opts ...grpc.CallOption) (*VisitListResponse, error) {
+	out := new(VisitListResponse)
+	err := c.cc.Invoke(ctx, "/pb.Visit/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visitClient) BatchGet(ctx context.Context, in *VisitBatchGetRequest, opts ...grpc.CallOption) (*VisitBatchGetResponse, error) {
+	out := new(VisitBatchGetResponse)
+	err := c.cc.Invoke(ctx, "/pb.Visit/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visitClient) BatchSet(ctx context.Context, in *VisitBatchSetRequest, opts ...grpc.CallOption) (*VisitBatchSetResponse, error) {
+	out := new(VisitBatchSetResponse)
+	err := c.cc.Invoke(ctx, "/pb.Visit/BatchSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visitClient) Watch(ctx context.Context, in *VisitWatchRequest, opts ...grpc.CallOption) (Visit_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Visit_ServiceDesc.Streams[0], "/pb.Visit/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &visitWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Visit_WatchClient interface {
+	Recv() (*VisitEvent, error)
+	grpc.ClientStream
+}
+
+type visitWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *visitWatchClient) Recv() (*VisitEvent, error) {
+	m := new(VisitEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *visitClient) ListAudit(ctx context.Context, in *VisitListAuditRequest, opts ...grpc.CallOption) (*VisitListAuditResponse, error) {
+	out := new(VisitListAuditResponse)
+	err := c.cc.Invoke(ctx, "/pb.Visit/ListAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visitClient) Delete(ctx context.Context, in *VisitDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pb.Visit/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *visitClient) Purge(ctx context.Context, in *VisitDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pb.Visit/Purge", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -56,8 +173,29 @@ func (c *visitClient) Set(ctx context.Context, in *VisitRequest, opts ...grpc.Ca
 type VisitServer interface {
 	// Simple return the visit id
 	Get(context.Context, *ID) (*VisitResponse, error)
-	// Update/Create a device
-	Set(context.Context, *VisitRequest) (*VisitResponse, error)
+	// Create a visit
+	Create(context.Context, *VisitRequest) (*VisitResponse, error)
+	// Update a visit, only the fields listed in UpdateMask are written.
+	// Over HTTP the mask defaults to the fields present in the body.
+	// Set Version (or the If-Match header) to fail with FAILED_PRECONDITION (412 over HTTP) if the visit was modified in the meantime
+	Update(context.Context, *VisitUpdateRequest) (*VisitResponse, error)
+	// List visits, supports filtering, ordering and cursor based pagination
+	List(context.Context, *VisitListRequest) (*VisitListResponse, error)
+	// Get multiple visits at once, missing visits are listed in NotFound
+	BatchGet(context.Context, *VisitBatchGetRequest) (*VisitBatchGetResponse, error)
+	// Create (no ID) or update multiple visits at once. Each visit reports its own result,
+	// unless Atomic is set; then the whole batch fails on the first error
+	BatchSet(context.Context, *VisitBatchSetRequest) (*VisitBatchSetResponse, error)
+	// Stream visit changes as they happen. Set LastEventID to resume after a disconnect.
+	// Events are kept in-process: only changes made through the same server instance are streamed,
+	// and event IDs and history are per instance, resume on the instance that issued them (single instance deployments)
+	Watch(*VisitWatchRequest, Visit_WatchServer) error
+	// List the changes of a visit, oldest first. Deleted and purged visits keep their history
+	ListAudit(context.Context, *VisitListAuditRequest) (*VisitListAuditResponse, error)
+	// Soft delete a visit, it's no longer returned but kept in the database
+	Delete(context.Context, *VisitDeleteRequest) (*emptypb.Empty, error)
+	// Remove a visit permanently, including a soft deleted one (e.g GDPR requests)
+	Purge(context.Context, *VisitDeleteRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedVisitServer()
 }
 
@@ -68,8 +206,32 @@ type UnimplementedVisitServer struct {
 func (UnimplementedVisitServer) Get(context.Context, *ID) (*VisitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedVisitServer) Set(context.Context, *VisitRequest) (*VisitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+func (UnimplementedVisitServer) Create(context.Context, *VisitRequest) (*VisitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedVisitServer) Update(context.Context, *VisitUpdateRequest) (*VisitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedVisitServer) List(context.Context, *VisitListRequest) (*VisitListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedVisitServer) BatchGet(context.Context, *VisitBatchGetRequest) (*VisitBatchGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (UnimplementedVisitServer) BatchSet(context.Context, *VisitBatchSetRequest) (*VisitBatchSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSet not implemented")
+}
+func (UnimplementedVisitServer) Watch(*VisitWatchRequest, Visit_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedVisitServer) ListAudit(context.Context, *VisitListAuditRequest) (*VisitListAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudit not implemented")
+}
+func (UnimplementedVisitServer) Delete(context.Context, *VisitDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedVisitServer) Purge(context.Context, *VisitDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
 }
 func (UnimplementedVisitServer) mustEmbedUnimplementedVisitServer() {}
 
@@ -102,20 +264,167 @@ func _Visit_Get_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Visit_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Visit_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VisitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VisitServer).Set(ctx, in)
+		return srv.(VisitServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Visit/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisitServer).Create(ctx, req.(*VisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Visit_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisitServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Visit/Set",
+		FullMethod: "/pb.Visit/Update",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VisitServer).Set(ctx, req.(*VisitRequest))
+		return srv.(VisitServer).Update(ctx, req.(*VisitUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Visit_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisitServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Visit/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisitServer).List(ctx, req.(*VisitListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Visit_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitBatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisitServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Visit/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisitServer).BatchGet(ctx, req.(*VisitBatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Visit_BatchSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitBatchSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisitServer).BatchSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Visit/BatchSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisitServer).BatchSet(ctx, req.(*VisitBatchSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Visit_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VisitWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VisitServer).Watch(m, &visitWatchServer{stream})
+}
+
+type Visit_WatchServer interface {
+	Send(*VisitEvent) error
+	grpc.ServerStream
+}
+
+type visitWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *visitWatchServer) Send(m *VisitEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Visit_ListAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitListAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisitServer).ListAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Visit/ListAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisitServer).ListAudit(ctx, req.(*VisitListAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Visit_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisitServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Visit/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisitServer).Delete(ctx, req.(*VisitDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Visit_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VisitServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Visit/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VisitServer).Purge(ctx, req.(*VisitDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -132,10 +441,44 @@ var Visit_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Visit_Get_Handler,
 		},
 		{
-			MethodName: "Set",
-			Handler:    _Visit_Set_Handler,
+			MethodName: "Create",
+			Handler:    _Visit_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Visit_Update_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Visit_List_Handler,
+		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _Visit_BatchGet_Handler,
+		},
+		{
+			MethodName: "BatchSet",
+			Handler:    _Visit_BatchSet_Handler,
+		},
+		{
+			MethodName: "ListAudit",
+			Handler:    _Visit_ListAudit_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Visit_Delete_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _Visit_Purge_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Visit_Watch_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "src/transport/grpc/proto/visit.proto",
 }
@@ -4,8 +4,17 @@ import (
 	"context"
 
 	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/etag"
+	grpc_status_app "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/status/app"
+	grpc_status_validator "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/status/validator.v10"
 	pb "github.com/eldad87/go-boilerplate/src/transport/grpc/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type VisitServer struct {
@@ -18,21 +27,19 @@ func (vs *VisitServer) Get(c context.Context, id *pb.ID) (*pb.VisitResponse, err
 	v, err := vs.VisitService.Get(c, &i)
 	if err != nil {
 		return nil, err
-	} else if v == nil {
-		return &pb.VisitResponse{}, nil
 	}
 
 	return vs.visitToProto(v)
 }
 
-// Update/Create a device
-func (vs *VisitServer) Set(c context.Context, v *pb.VisitRequest) (*pb.VisitResponse, error) {
+// Create a visit
+func (vs *VisitServer) Create(c context.Context, v *pb.VisitRequest) (*pb.VisitResponse, error) {
 	aVis, err := vs.protoToVisit(v)
 	if err != nil {
 		return nil, err
 	}
 
-	gVis, err := vs.VisitService.Set(c, aVis)
+	gVis, err := vs.VisitService.Create(c, aVis)
 	if err != nil {
 		return nil, err
 	}
@@ -40,6 +47,195 @@ func (vs *VisitServer) Set(c context.Context, v *pb.VisitRequest) (*pb.VisitResp
 	return vs.visitToProto(gVis)
 }
 
+// Update the fields listed in the update mask
+func (vs *VisitServer) Update(c context.Context, r *pb.VisitUpdateRequest) (*pb.VisitResponse, error) {
+	aVis := &app.Visit{
+		ID:        uint(r.GetVisit().GetID()),
+		FirstName: r.GetVisit().GetFirstName(),
+		LastName:  r.GetVisit().GetLastName(),
+		Version:   uint(r.GetVisit().GetVersion()),
+	}
+
+	// Fallback to the HTTP If-Match header
+	if aVis.Version == 0 {
+		version, err := etag.FromIncomingContext(c)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid If-Match header")
+		}
+		aVis.Version = uint(version)
+	}
+
+	// The ID identifies the record and the version is a precondition, neither is updated.
+	// The gateway masks every key of the request body, including them
+	var fields []string
+	for _, path := range r.GetUpdateMask().GetPaths() {
+		if path != "ID" && path != "Version" {
+			fields = append(fields, path)
+		}
+	}
+
+	gVis, err := vs.VisitService.Update(c, aVis, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return vs.visitToProto(gVis)
+}
+
+// Get multiple visits at once
+func (vs *VisitServer) BatchGet(c context.Context, r *pb.VisitBatchGetRequest) (*pb.VisitBatchGetResponse, error) {
+	ids := make([]uint, len(r.GetIDs()))
+	for i, id := range r.GetIDs() {
+		ids[i] = uint(id)
+	}
+
+	visits, err := vs.VisitService.BatchGet(c, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.VisitBatchGetResponse{}
+	found := make(map[uint32]bool, len(visits))
+	for _, v := range visits {
+		pVis, err := vs.visitToProto(v)
+		if err != nil {
+			return nil, err
+		}
+		res.Visits = append(res.Visits, pVis)
+		found[pVis.ID] = true
+	}
+
+	for _, id := range r.GetIDs() {
+		if !found[id] {
+			res.NotFound = append(res.NotFound, id)
+		}
+	}
+
+	return res, nil
+}
+
+// Create/Update multiple visits at once
+func (vs *VisitServer) BatchSet(c context.Context, r *pb.VisitBatchSetRequest) (*pb.VisitBatchSetResponse, error) {
+	visits := make([]*app.Visit, len(r.GetVisits()))
+	for i, v := range r.GetVisits() {
+		visits[i] = &app.Visit{
+			ID:        uint(v.GetID()),
+			FirstName: v.GetFirstName(),
+			LastName:  v.GetLastName(),
+			Version:   uint(v.GetVersion()),
+		}
+	}
+
+	results, err := vs.VisitService.BatchSet(c, visits, r.GetAtomic())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.VisitBatchSetResponse{Results: make([]*pb.VisitBatchSetResult, len(results))}
+	for i, result := range results {
+		res.Results[i] = &pb.VisitBatchSetResult{}
+		if result.Err != nil {
+			res.Results[i].Error = errorToStatus(result.Err)
+			continue
+		}
+
+		if res.Results[i].Visit, err = vs.visitToProto(result.Visit); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// Stream visit changes
+func (vs *VisitServer) Watch(r *pb.VisitWatchRequest, stream pb.Visit_WatchServer) error {
+	events, err := vs.VisitService.Watch(stream.Context(), r.GetLastEventID())
+	if err != nil {
+		return err
+	}
+
+	for e := range events {
+		pEvent, err := vs.visitEventToProto(e)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(pEvent); err != nil {
+			return err
+		}
+	}
+
+	// Events stopped while the client is still there, it lagged behind
+	if stream.Context().Err() == nil {
+		return app.NewAbortedError(app.VisitResource, "", "watcher lagged behind, resume using the last event ID")
+	}
+
+	return nil
+}
+
+// Soft delete a visit
+func (vs *VisitServer) Delete(c context.Context, r *pb.VisitDeleteRequest) (*empty.Empty, error) {
+	i := uint(r.GetID())
+	if err := vs.VisitService.Delete(c, &i); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// Purge a visit permanently
+func (vs *VisitServer) Purge(c context.Context, r *pb.VisitDeleteRequest) (*empty.Empty, error) {
+	i := uint(r.GetID())
+	if err := vs.VisitService.Purge(c, &i); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// List the changes of a visit
+func (vs *VisitServer) ListAudit(c context.Context, r *pb.VisitListAuditRequest) (*pb.VisitListAuditResponse, error) {
+	audits, err := vs.VisitService.ListAudit(c, uint(r.GetVisitID()))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.VisitListAuditResponse{}
+	for _, audit := range audits {
+		pAudit, err := vs.visitAuditToProto(audit)
+		if err != nil {
+			return nil, err
+		}
+		res.Audits = append(res.Audits, pAudit)
+	}
+
+	return res, nil
+}
+
+// List visits
+func (vs *VisitServer) List(c context.Context, r *pb.VisitListRequest) (*pb.VisitListResponse, error) {
+	f, err := vs.protoToVisitFilter(r)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := vs.VisitService.List(c, f)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.VisitListResponse{NextPageToken: page.NextPageToken}
+	for _, v := range page.Visits {
+		pVis, err := vs.visitToProto(v)
+		if err != nil {
+			return nil, err
+		}
+		res.Visits = append(res.Visits, pVis)
+	}
+
+	return res, nil
+}
+
 func (vs *VisitServer) visitToProto(visit *app.Visit) (*pb.VisitResponse, error) {
 	created, err := ptypes.TimestampProto(visit.CreatedAt)
 	if err != nil {
@@ -51,13 +247,122 @@ func (vs *VisitServer) visitToProto(visit *app.Visit) (*pb.VisitResponse, error)
 		return nil, err
 	}
 
-	return &pb.VisitResponse{ID: uint32(visit.ID), FirstName: visit.FirstName, LastName: visit.LastName, CreatedAt: created, UpdatedAt: updated}, nil
+	return &pb.VisitResponse{ID: uint32(visit.ID), FirstName: visit.FirstName, LastName: visit.LastName, CreatedAt: created, UpdatedAt: updated, Version: uint32(visit.Version)}, nil
+}
+
+func (vs *VisitServer) visitEventToProto(e *app.VisitEvent) (*pb.VisitEvent, error) {
+	created, err := ptypes.TimestampProto(e.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	pEvent := &pb.VisitEvent{ID: e.ID, CreatedAt: created}
+	switch e.Type {
+	case app.VisitEventCreated:
+		pEvent.Type = pb.VisitEvent_CREATED
+	case app.VisitEventUpdated:
+		pEvent.Type = pb.VisitEvent_UPDATED
+	case app.VisitEventDeleted:
+		pEvent.Type = pb.VisitEvent_DELETED
+	}
+
+	if e.Type == app.VisitEventDeleted {
+		pEvent.Visit = &pb.VisitResponse{ID: uint32(e.Visit.ID)}
+	} else if pEvent.Visit, err = vs.visitToProto(e.Visit); err != nil {
+		return nil, err
+	}
+
+	return pEvent, nil
+}
+
+func (vs *VisitServer) visitAuditToProto(a *app.VisitAudit) (*pb.VisitAudit, error) {
+	created, err := ptypes.TimestampProto(a.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	pAudit := &pb.VisitAudit{
+		ID:        a.ID,
+		VisitID:   uint32(a.VisitID),
+		Principal: a.Actor.Principal,
+		RequestID: a.Actor.RequestID,
+		TraceID:   a.Actor.TraceID,
+		CreatedAt: created,
+	}
+	switch a.Action {
+	case app.VisitAuditCreated:
+		pAudit.Action = pb.VisitAudit_CREATED
+	case app.VisitAuditUpdated:
+		pAudit.Action = pb.VisitAudit_UPDATED
+	case app.VisitAuditDeleted:
+		pAudit.Action = pb.VisitAudit_DELETED
+	case app.VisitAuditPurged:
+		pAudit.Action = pb.VisitAudit_PURGED
+	}
+
+	if pAudit.OldValues, err = jsonToStruct(a.OldValues); err != nil {
+		return nil, err
+	}
+	if pAudit.NewValues, err = jsonToStruct(a.NewValues); err != nil {
+		return nil, err
+	}
+
+	return pAudit, nil
+}
+
+// jsonToStruct converts a JSON object, nil if there is none
+func jsonToStruct(b []byte) (*structpb.Struct, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	s := &structpb.Struct{}
+	if err := protojson.Unmarshal(b, s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
 }
 
 func (vs *VisitServer) protoToVisit(visit *pb.VisitRequest) (*app.Visit, error) {
 	return &app.Visit{
-		ID:        uint(visit.ID),
 		FirstName: visit.FirstName,
 		LastName:  visit.LastName,
 	}, nil
 }
+
+func (vs *VisitServer) protoToVisitFilter(r *pb.VisitListRequest) (*app.VisitFilter, error) {
+	f := &app.VisitFilter{
+		FirstNamePrefix: r.FirstNamePrefix,
+		LastNamePrefix:  r.LastNamePrefix,
+		OrderBy:         r.OrderBy,
+		Descending:      r.Descending,
+		PageSize:        uint(r.PageSize),
+		PageToken:       r.PageToken,
+	}
+
+	if r.CreatedAfter != nil {
+		createdAfter, err := ptypes.Timestamp(r.CreatedAfter)
+		if err != nil {
+			return nil, err
+		}
+		f.CreatedAfter = &createdAfter
+	}
+
+	if r.CreatedBefore != nil {
+		createdBefore, err := ptypes.Timestamp(r.CreatedBefore)
+		if err != nil {
+			return nil, err
+		}
+		f.CreatedBefore = &createdBefore
+	}
+
+	return f, nil
+}
+
+// errorToStatus converts an error the same way our interceptors would
+func errorToStatus(err error) *spb.Status {
+	err = grpc_status_validator.ErrorHandler(err)
+	err = grpc_status_app.ErrorHandler(err)
+	return status.Convert(err).Proto()
+}
@@ -0,0 +1,204 @@
+package grpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eldad87/go-boilerplate/src/app"
+	"github.com/eldad87/go-boilerplate/src/app/memory"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc-gateway/etag"
+	"github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/auth"
+	grpc_status_app "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/status/app"
+	grpc_status_validator "github.com/eldad87/go-boilerplate/src/pkg/grpc/middleware/status/validator.v10"
+	transport "github.com/eldad87/go-boilerplate/src/transport/grpc"
+	pb "github.com/eldad87/go-boilerplate/src/transport/grpc/proto"
+	v10validator "github.com/go-playground/validator/v10"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testTenant = "acme"
+
+// newGateway serves a VisitServer of visits through the HTTP gateway, the same way the app does
+func newGateway(t *testing.T, visits app.VisitService) http.Handler {
+	t.Helper()
+
+	withTenant := func(c context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(app.WithTenant(c, testTenant), req)
+	}
+
+	server := grpc.NewServer(grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+		withTenant,
+		grpc_status_validator.UnaryServerInterceptor(),
+		grpc_status_app.UnaryServerInterceptor(),
+	)))
+	pb.RegisterVisitServer(server, &transport.VisitServer{VisitService: visits})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	mux := runtime.NewServeMux(
+		runtime.WithMetadata(etag.Metadata),
+		runtime.WithForwardResponseOption(etag.ForwardResponseOption),
+		runtime.WithErrorHandler(etag.ErrorHandler(nil, app.ReasonVersionMismatch)),
+	)
+	if err := pb.RegisterVisitHandler(context.Background(), mux, conn); err != nil {
+		t.Fatal(err)
+	}
+
+	return mux
+}
+
+func TestVisitServer_Update_Gateway(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		ifMatch string
+		// Version of the visit before the request
+		version       int
+		wantStatus    int
+		wantFirstName string
+		wantETag      string
+	}{
+		{name: "body without version", body: `{"FirstName": "Jane"}`, version: 1,
+			wantStatus: http.StatusOK, wantFirstName: "Jane", wantETag: `"2"`},
+		{name: "ID and version in the body aren't updated", body: `{"ID": %d, "FirstName": "Jane", "Version": 1}`, version: 1,
+			wantStatus: http.StatusOK, wantFirstName: "Jane", wantETag: `"2"`},
+		{name: "stale version in the body", body: `{"FirstName": "Jane", "Version": 1}`, version: 2,
+			wantStatus: http.StatusPreconditionFailed},
+		{name: "matching If-Match", body: `{"FirstName": "Jane"}`, ifMatch: `"2"`, version: 2,
+			wantStatus: http.StatusOK, wantFirstName: "Jane", wantETag: `"3"`},
+		{name: "stale If-Match", body: `{"FirstName": "Jane"}`, ifMatch: `"1"`, version: 2,
+			wantStatus: http.StatusPreconditionFailed},
+		{name: "invalid If-Match", body: `{"FirstName": "Jane"}`, ifMatch: "*", version: 1,
+			wantStatus: http.StatusBadRequest},
+		{name: "invalid masked field", body: `{"FirstName": "J"}`, version: 1,
+			wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visits := memory.NewVisitService(v10validator.New(), app.NewVisitEvents(10, 10))
+			gateway := newGateway(t, visits)
+
+			c := app.WithTenant(context.Background(), testTenant)
+			v, err := visits.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for v.Version < uint(tt.version) {
+				if v, err = visits.Update(c, &app.Visit{ID: v.ID, FirstName: "John"}, []string{app.VisitFieldFirstName}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			body := tt.body
+			if strings.Contains(body, "%d") {
+				body = fmt.Sprintf(body, v.ID)
+			}
+			req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/v1/visit/%d", v.ID), strings.NewReader(body))
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			gateway.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var res struct {
+				ID        uint
+				FirstName string
+				LastName  string
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+				t.Fatal(err)
+			}
+			if res.ID != v.ID || res.FirstName != tt.wantFirstName || res.LastName != "Doe" {
+				t.Errorf("expected visit %d %s Doe, got %+v", v.ID, tt.wantFirstName, res)
+			}
+			if etag := rec.Header().Get("ETag"); etag != tt.wantETag {
+				t.Errorf("expected ETag %s, got %s", tt.wantETag, etag)
+			}
+		})
+	}
+}
+
+// principalAuthenticator authenticates every request as p
+type principalAuthenticator struct {
+	p *auth.Principal
+}
+
+func (a principalAuthenticator) Authenticate(ctx context.Context) (*auth.Principal, error) {
+	return a.p, nil
+}
+
+func TestVisitServer_Delete_Authorization(t *testing.T) {
+	editor := &auth.Principal{Subject: "editor", Scopes: []string{"visit.write"}, Roles: []string{"editor"}}
+	admin := &auth.Principal{Subject: "admin", Scopes: []string{"visit.purge"}, Roles: []string{"admin"}}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		method    string
+		wantCode  codes.Code
+		wantFound bool
+	}{
+		{name: "editor deletes", principal: editor, method: "Delete", wantCode: codes.OK},
+		{name: "editor purges", principal: editor, method: "Purge", wantCode: codes.PermissionDenied, wantFound: true},
+		{name: "admin purges", principal: admin, method: "Purge", wantCode: codes.OK},
+		{name: "admin deletes", principal: admin, method: "Delete", wantCode: codes.PermissionDenied, wantFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visits := memory.NewVisitService(v10validator.New(), app.NewVisitEvents(10, 10))
+			vs := &transport.VisitServer{VisitService: visits}
+
+			c := app.WithTenant(context.Background(), testTenant)
+			v, err := visits.Create(c, &app.Visit{FirstName: "John", LastName: "Doe"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			interceptor := auth.UnaryServerInterceptor(true, principalAuthenticator{tt.principal})
+			info := &grpc.UnaryServerInfo{FullMethod: "/pb.Visit/" + tt.method}
+			_, err = interceptor(c, &pb.VisitDeleteRequest{ID: uint32(v.ID)}, info, func(c context.Context, req interface{}) (interface{}, error) {
+				if tt.method == "Purge" {
+					return vs.Purge(c, req.(*pb.VisitDeleteRequest))
+				}
+				return vs.Delete(c, req.(*pb.VisitDeleteRequest))
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("expected code %s, got %v", tt.wantCode, err)
+			}
+
+			if _, err := visits.Get(c, &v.ID); app.IsNotFound(err) == tt.wantFound {
+				t.Errorf("expected the visit to be found %v, got %v", tt.wantFound, err)
+			}
+		})
+	}
+}